@@ -1,11 +1,10 @@
 package assert
 
 import (
-	"encoding/json"
 	"errors"
 	"fmt"
 	"reflect"
-	"testing"
+	"strings"
 )
 
 // nilabe types
@@ -14,10 +13,18 @@ var nilableKinds = []reflect.Kind{
 	reflect.Interface, reflect.Ptr, reflect.Slice,
 }
 
+// TestingT is the subset of testing.TB used to report failed assertions. It's satisfied by
+// *testing.T, *testing.B and *testing.F, as well as by custom test runners and fakes.
+type TestingT interface {
+	Fail()
+	Fatal(args ...interface{})
+	Log(args ...interface{})
+}
+
 // New returns an assert function, which is used to make assertions.
 // If any assertion fails using this function, code execution is allowed to continue,
 // but the test is marked as having failed.
-func New(t *testing.T) func(got interface{}) asserter {
+func New(t TestingT) func(got interface{}) asserter {
 	return func(got interface{}) asserter {
 		return asserter{
 			got:   got,
@@ -30,7 +37,7 @@ func New(t *testing.T) func(got interface{}) asserter {
 // NewFatal returns an assert function, which is used to make assertions.
 // If any assertion fails using this function, code execution is immediately stopped
 // and the test is marked as having failed.
-func NewFatal(t *testing.T) func(got interface{}) asserter {
+func NewFatal(t TestingT) func(got interface{}) asserter {
 	return func(got interface{}) asserter {
 		return asserter{
 			got:   got,
@@ -40,37 +47,107 @@ func NewFatal(t *testing.T) func(got interface{}) asserter {
 	}
 }
 
+// Asserter is the type of the values returned by the assert functions. It's used to declare
+// callbacks receiving an asserter, such as the ones passed to the Each method.
+type Asserter = asserter
+
 type asserter struct {
 	got   interface{}
-	t     *testing.T
+	t     TestingT
 	fatal bool
+	// path locates the observed value within the value it was reached from, e.g. [3], and is
+	// used to label failures
+	path string
+	// jsonCfg is set if the observed value was selected from a JSON document, in which case
+	// expected values are converted to JSON trees before they're compared
+	jsonCfg *jsonConfig
+	// navigationFailed is set if the observed value couldn't be reached by a navigation method,
+	// such as Field. The failure has already been reported, so assertions fail without reporting.
+	navigationFailed bool
 }
 
-func (a *asserter) errorf(msg string, want interface{}, hasWant bool) {
+func (a *asserter) errorf(msg string, want interface{}, hasWant bool, details ...string) {
+	if a.path != "" {
+		msg = a.path + ": " + msg
+	}
+
+	if recorder := recorderOf(a.t); recorder != nil {
+		recorder.record(failureDescription(msg, want, a.got, hasWant, details...))
+		return
+	}
+
 	if a.fatal {
-		a.t.Fatal(errorMsg(msg, want, a.got, hasWant))
+		a.t.Fatal(errorMsg(msg, want, a.got, hasWant, details...))
 		return
 	}
 
 	a.t.Fail()
-	a.t.Log(errorMsg(msg, want, a.got, hasWant))
+	a.t.Log(errorMsg(msg, want, a.got, hasWant, details...))
 }
 
 // Equals asserts the observed value equals the 'want' argument (expected value).
 // They are considered equal if both are nil or if they're deeply equal according to
-// reflect.DeepEqual's definition of equal.
-func (a asserter) Equals(want interface{}) bool {
-	if err := validateArgsForEqualsFn(a.got, want); err != nil {
+// reflect.DeepEqual's definition of equal, except that values of types with an Equal(T) bool
+// or Cmp(T) int method are compared using that method. Methods can't be called on values reached
+// through unexported struct fields, so those values are compared like in reflect.DeepEqual. The
+// comparison can be adjusted with options.
+//
+//	Example:
+//		assert(got).Equals(want, assert.IgnoreFields("ID"), assert.TreatNilAndEmptyAsEqual())
+func (a asserter) Equals(want interface{}, opts ...EqualOption) bool {
+	if a.navigationFailed {
+		return false
+	}
+	want, opts = a.jsonWant(want, opts)
+	cfg, err := newEqualConfig(opts)
+	if err == nil {
+		err = validateArgsForEqualsFn(a.got, want)
+	}
+	if err != nil {
 		a.errorf(fmt.Sprintf("Invalid argument: %v", err), want, true)
 		return false
 	}
-	if !equals(a.got, want) {
-		a.errorf("Observed and expected values must be equal", want, true)
+	if !equalsWith(a.got, want, cfg) {
+		details, isTextDiff := differenceDetails(want, a.got, cfg)
+		if isTextDiff {
+			// The texts are summarized, since the diff already shows their differing lines
+			summarized := a
+			summarized.got = textSummary(a.got)
+			summarized.errorf("Observed and expected values must be equal", textSummary(want), true,
+				details...)
+			return false
+		}
+		a.errorf("Observed and expected values must be equal", want, true, details...)
 		return false
 	}
 	return true
 }
 
+// differenceDetails returns the paths at which want and got differ as failure message details.
+// Nothing is returned when values of the same type only differ at the root, since the failure
+// message already shows both values in full. Values of different types are described along with
+// their types, since they may look the same, e.g. int64(3) and 3. Multi-line strings and byte
+// slices of the same type are shown as a line based diff, unless they differ too much to be
+// diffed. The second return value is true if the diff shows differing lines, rather than only
+// describing differing line endings.
+func differenceDetails(want, got interface{}, cfg equalConfig) ([]string, bool) {
+	typesDiffer := want != nil && got != nil && reflect.TypeOf(want) != reflect.TypeOf(got)
+	wantText, wantIsText := rawText(want)
+	gotText, gotIsText := rawText(got)
+	if !typesDiffer && wantIsText && gotIsText && (isMultiLine(wantText) || isMultiLine(gotText)) {
+		if textDiff, ok := unifiedDiff(wantText, gotText); ok {
+			return []string{textDiff}, !reflect.DeepEqual(lines(wantText), lines(gotText))
+		}
+		return nil, false
+	}
+
+	diffs := diff(want, got, cfg)
+	if len(diffs) == 1 && diffs[0].path == "" && !typesDiffer {
+		return nil, false
+	}
+	return formatDifferences(diffs), false
+}
+
 func validateArgsForEqualsFn(a, b interface{}) error {
 	if a == nil && b == nil {
 		return nil
@@ -82,71 +159,174 @@ func validateArgsForEqualsFn(a, b interface{}) error {
 }
 
 func equals(got, want interface{}) bool {
-	if got == nil && want == nil {
-		return true
+	return equalsWith(got, want, equalConfig{})
+}
+
+// IgnoringOrderEqualsElementsIn asserts the observed value is equal to the 'want' argument
+// (expected value), ignoring order. Valid types for comparison are slices and arrays, but it's
+// also valid to compare slices with arrays and vice versa. Comparing other types or if the
+// values being compared are not equal, the function under test is marked as having failed.
+// Two sequences of elements are equal if every element occurs the same number of times in both,
+// where elements are compared like in the Equals method. Any element type is supported, and the
+// comparison can be adjusted with the same options as the Equals method.
+func (a asserter) IgnoringOrderEqualsElementsIn(want interface{}, opts ...EqualOption) bool {
+	if a.navigationFailed {
+		return false
+	}
+	want, opts = a.jsonWant(want, opts)
+	if !isList(a.got) || !isList(want) {
+		a.errorf("Invalid argument", want, true)
+		return false
 	}
 
-	if got == nil || want == nil {
+	cfg, err := newEqualConfig(opts)
+	if err != nil {
+		a.errorf(fmt.Sprintf("Invalid argument: %v", err), want, true)
 		return false
 	}
 
-	if !reflect.DeepEqual(got, want) {
+	missing, unexpected := multisetDifference(reflect.ValueOf(want), reflect.ValueOf(a.got), cfg)
+	if len(missing) > 0 || len(unexpected) > 0 {
+		a.errorf("Observed and expected elements must be equal, ignoring order", want, true,
+			fmt.Sprintf("Missing elements: %v", formatElements(missing)),
+			fmt.Sprintf("Unexpected elements: %v", formatElements(unexpected)),
+		)
 		return false
 	}
+
 	return true
 }
 
-// IgnoringOrderEqualsElementsIn asserts the observed value is equal to the 'want' argument
-// (expected value), ignoring order. Valid types for comparison are slices and arrays, but it's
-// also valid to compare slices with arrays and vice versa. Comparing other types or if the
-// values being compared are not equal, the function under test is marked as having failed.
-// Two sequences of elements are equal if their number of elements are the
-// same, and if their elements are equal ignoring order.
-func (a asserter) IgnoringOrderEqualsElementsIn(want interface{}) bool {
-	if !isList(a.got) || !isList(want) {
-		a.errorf("Invalid argument", want, true)
-		return false
+// multisetDifference matches every element in the 'want' sequence with an equal, not previously
+// matched, element in the 'got' sequence. It returns the elements of 'want' that couldn't be
+// matched (missing) and the elements of 'got' that weren't matched (unexpected).
+func multisetDifference(want, got reflect.Value, cfg equalConfig) (missing, unexpected []interface{}) {
+	wantElems, gotElems := sequenceElements(want), sequenceElements(got)
+	// The differ is reused for every comparison of a want and a got element
+	d := newDiffer(cfg)
+	d.firstOnly = true
+	m := newElementMatching(len(wantElems), len(gotElems), func(w, g int) bool {
+		d.diffs = d.diffs[:0]
+		d.walk(valuePath{}, wantElems[w], gotElems[g])
+		return len(d.diffs) == 0
+	})
+
+	for _, w := range m.match() {
+		missing = append(missing, want.Index(w).Interface())
+	}
+	for g, w := range m.wantOf {
+		if w < 0 {
+			unexpected = append(unexpected, got.Index(g).Interface())
+		}
 	}
+	return missing, unexpected
+}
 
-	if isEmpty(a.got) && isEmpty(want) {
-		return true
+// sequenceElements returns the elements of a slice or array. Elements of interface type are
+// replaced by their dynamic values, like when they're compared by the Equals method.
+func sequenceElements(seq reflect.Value) []reflect.Value {
+	elems := make([]reflect.Value, seq.Len())
+	for i := range elems {
+		elems[i] = reflect.ValueOf(seq.Index(i).Interface())
 	}
+	return elems
+}
 
-	matchingElemCountFor := make(map[interface{}]int)
-	gotElemSequence := reflect.ValueOf(a.got)
-	gotElemSequenceLen := gotElemSequence.Len()
-	wantElemCountFor := make(map[interface{}]int)
-	wantElemSequence := reflect.ValueOf(want)
-	wantElemSequenceLen := wantElemSequence.Len()
-
-	for w := 0; w < wantElemSequenceLen; w++ {
-		wantElem := wantElemSequence.Index(w).Interface()
-		wantElemCountFor[wantElem]++
-		for g := 0; g < gotElemSequenceLen; g++ {
-			gotElem := gotElemSequence.Index(g).Interface()
-			if reflect.DeepEqual(wantElem, gotElem) {
-				matchingElemCountFor[gotElem]++
-			}
+// elementMatching pairs elements of a 'want' sequence with equal elements of a 'got' sequence,
+// where the elements are identified by their indexes.
+type elementMatching struct {
+	// equal reports whether the want element 'w' equals the got element 'g'
+	equal func(w, g int) bool
+	// rows holds, for each want element, whether it equals each got element. It's only computed
+	// for the want elements reached when rematching.
+	rows [][]bool
+	// wantOf holds the index of the want element matched with each got element, or -1
+	wantOf []int
+}
+
+func newElementMatching(wantLen, gotLen int, equal func(w, g int) bool) *elementMatching {
+	m := &elementMatching{equal: equal, rows: make([][]bool, wantLen), wantOf: make([]int, gotLen)}
+	for g := range m.wantOf {
+		m.wantOf[g] = -1
+	}
+	return m
+}
+
+// match matches every want element with an equal, not previously matched, got element, and
+// returns the indexes of the want elements that couldn't be matched. Since equality with a
+// tolerance, a custom comparer or a placeholder isn't necessarily transitive, elements that can't
+// be matched right away are matched by rematching previously matched elements, so that the
+// largest possible number of elements is matched.
+func (m *elementMatching) match() (unmatched []int) {
+	var pending []int
+	for w := range m.rows {
+		if !m.matchFirstEqual(w) {
+			pending = append(pending, w)
+		}
+	}
+	for _, w := range pending {
+		if !m.augment(w, make([]bool, len(m.wantOf))) {
+			unmatched = append(unmatched, w)
+		}
+	}
+	return unmatched
+}
+
+// row returns whether the want element 'w' equals each got element.
+func (m *elementMatching) row(w int) []bool {
+	if m.rows[w] == nil {
+		m.rows[w] = make([]bool, len(m.wantOf))
+		for g := range m.wantOf {
+			m.rows[w][g] = m.equal(w, g)
 		}
 	}
+	return m.rows[w]
+}
 
-	wantElemCount := len(wantElemCountFor)
-	gotMatchingElemCount := len(matchingElemCountFor)
+// matchFirstEqual matches the want element 'w' with the first equal, unmatched got element.
+func (m *elementMatching) matchFirstEqual(w int) bool {
+	for g, matchedWant := range m.wantOf {
+		if matchedWant < 0 && m.equal(w, g) {
+			m.wantOf[g] = w
+			return true
+		}
+	}
+	return false
+}
 
-	if wantElemCount != gotMatchingElemCount {
-		a.errorf(fmt.Sprintf("No of matching elements must be equal, want = %d, got = %d", wantElemCount, gotMatchingElemCount), want, true)
-		return false
+// augment matches the want element 'w' with an equal got element, whose previously matched want
+// element is in turn rematched with another got element, and so on. Got elements in 'visited'
+// have already been tried.
+func (m *elementMatching) augment(w int, visited []bool) bool {
+	equal := m.row(w)
+	for g, matchedWant := range m.wantOf {
+		if visited[g] || !equal[g] {
+			continue
+		}
+		visited[g] = true
+		if matchedWant < 0 || m.augment(matchedWant, visited) {
+			m.wantOf[g] = w
+			return true
+		}
 	}
+	return false
+}
 
-	return true
+// formatElements returns a short human readable representation of the elements.
+func formatElements(elems []interface{}) string {
+	formatted := make([]string, 0, len(elems))
+	for _, elem := range elems {
+		formatted = append(formatted, formatValue(reflect.ValueOf(elem)))
+	}
+	return "[" + strings.Join(formatted, ", ") + "]"
 }
 
 func isList(list interface{}) bool {
-	kind := reflect.ValueOf(list).Kind()
-	if kind != reflect.Array && kind != reflect.Slice {
-		return false
-	}
-	return true
+	return isListKind(reflect.ValueOf(list).Kind())
+}
+
+func isListKind(kind reflect.Kind) bool {
+	return kind == reflect.Array || kind == reflect.Slice
 }
 
 // IsEmpty asserts the observed value is empty. If not empty, the function under test is
@@ -155,6 +335,9 @@ func isList(list interface{}) bool {
 // Pointers are considered empty if the referenced values are nil.
 // For all other types, the zero value is considered empty.
 func (a asserter) IsEmpty() bool {
+	if a.navigationFailed {
+		return false
+	}
 	isEmpty := isEmpty(a.got)
 	if !isEmpty {
 		a.errorf("Observed value must be empty", nil, false)
@@ -187,6 +370,9 @@ func isEmpty(obj interface{}) bool {
 // IsFunction asserts the observed value is a function value. If not, the function under test
 // is marked as having failed.
 func (a asserter) IsFunction() bool {
+	if a.navigationFailed {
+		return false
+	}
 	isFunc := isFunc(a.got)
 	if !isFunc {
 		a.errorf("Observed value must be a function", nil, false)
@@ -203,7 +389,13 @@ func isFunc(arg interface{}) bool {
 
 // IsNil asserts the observed value is nil. If not nil, the function
 // under test is marked as having failed.
+// Both nil interfaces and nil values of nilable types, such as pointers, are considered nil.
+// This means an error interface holding a nil pointer (typed nil) is considered nil, even though
+// err != nil is true for it. Use IsNilInterface to tell them apart.
 func (a asserter) IsNil() bool {
+	if a.navigationFailed {
+		return false
+	}
 	isNil := isNil(a.got)
 	if !isNil {
 		a.errorf("Observed value must be nil", nil, false)
@@ -211,6 +403,38 @@ func (a asserter) IsNil() bool {
 	return isNil
 }
 
+// IsNilInterface asserts the observed value is a nil interface. Unlike IsNil, a non-nil
+// interface holding a nil pointer (typed nil) is not considered nil. If not a nil interface,
+// the function under test is marked as having failed.
+func (a asserter) IsNilInterface() bool {
+	if a.navigationFailed {
+		return false
+	}
+	if a.got != nil {
+		a.errorf("Observed value must be a nil interface", nil, false)
+		return false
+	}
+	return true
+}
+
+// IsTypedNil asserts the observed value is a non-nil interface holding a nil value, such as an
+// error interface holding a nil pointer. If not, the function under test is marked as having
+// failed.
+func (a asserter) IsTypedNil() bool {
+	if a.navigationFailed {
+		return false
+	}
+	isTypedNil := isTypedNil(a.got)
+	if !isTypedNil {
+		a.errorf("Observed value must be a non-nil interface holding a nil value", nil, false)
+	}
+	return isTypedNil
+}
+
+func isTypedNil(got interface{}) bool {
+	return got != nil && isNil(got)
+}
+
 func isNil(got interface{}) bool {
 	if got == nil {
 		return true
@@ -232,6 +456,9 @@ func isNil(got interface{}) bool {
 // IsNotEmpty asserts the observed value isn't empty. If empty, the function
 // under test is marked as having failed.
 func (a asserter) IsNotEmpty() bool {
+	if a.navigationFailed {
+		return false
+	}
 	isEmpty := isEmpty(a.got)
 	if isEmpty {
 		a.errorf("Observed value must non-empty", nil, false)
@@ -242,6 +469,9 @@ func (a asserter) IsNotEmpty() bool {
 // IsNotNil asserts the observed value is not nil. If nil, the function
 // under test is marked as having failed.
 func (a asserter) IsNotNil() bool {
+	if a.navigationFailed {
+		return false
+	}
 	isNil := isNil(a.got)
 	if isNil {
 		a.errorf("Observed value must not be nil", nil, false)
@@ -253,6 +483,9 @@ func (a asserter) IsNotNil() bool {
 // under test is marked as having failed. Only a boolean value of true
 // returns true, for all other cases it returns false.
 func (a asserter) IsTrue() bool {
+	if a.navigationFailed {
+		return false
+	}
 	isTrue := isTrue((a.got))
 	if !isTrue {
 		a.errorf("Observed value must be true", nil, false)
@@ -279,6 +512,9 @@ func isTrue(got interface{}) bool {
 // under test is marked as having failed. Only a boolean value of false
 // returns true, for all other cases it returns false.
 func (a asserter) IsFalse() bool {
+	if a.navigationFailed {
+		return false
+	}
 	isFalse := isFalse(a.got)
 	if !isFalse {
 		a.errorf("Observed value must be false", nil, false)
@@ -308,6 +544,9 @@ func isFalse(got interface{}) bool {
 // the pointers don't point to the same memory address, the function under test is marked
 // as having failed.
 func (a asserter) IsPointerWithSameAddressAs(want interface{}) bool {
+	if a.navigationFailed {
+		return false
+	}
 	isPointerWithSameAddressAs := isPointerWithSameAddressAs(a.got, want)
 	if !isPointerWithSameAddressAs {
 		a.errorf("Observed pointer must be the same as the expected", want, true)
@@ -336,12 +575,20 @@ func isPointerWithSameAddressAs(got, want interface{}) bool {
 // NotEquals asserts the observed value is not equal to the 'want' argument. It performs the
 // same comparison as the Equals method, but inverts the result. If they are equal, the function
 // under test is marked as having failed.
-func (a asserter) NotEquals(want interface{}) bool {
-	if err := validateArgsForEqualsFn(a.got, want); err != nil {
+func (a asserter) NotEquals(want interface{}, opts ...EqualOption) bool {
+	if a.navigationFailed {
+		return false
+	}
+	want, opts = a.jsonWant(want, opts)
+	cfg, err := newEqualConfig(opts)
+	if err == nil {
+		err = validateArgsForEqualsFn(a.got, want)
+	}
+	if err != nil {
 		a.errorf(fmt.Sprintf("Invalid argument: %v", err), want, true)
 		return false
 	}
-	if equals(a.got, want) {
+	if equalsWith(a.got, want, cfg) {
 		a.errorf("Observed and expected values must be unequal", want, true)
 		return false
 	}
@@ -351,82 +598,34 @@ func (a asserter) NotEquals(want interface{}) bool {
 
 // IsJSONEqualTo asserts the observed value is valid JSON and that it equals the 'want' argument.
 // If not equal, the function under test is marked as having failed.
-func (a asserter) IsJSONEqualTo(want interface{}) bool {
-	if isNil(a.got) && isNil(want) {
-		return true
-	}
-
-	if a.got == nil || want == nil {
-		a.errorf("Invalid argument", want, true)
-		return false
-	}
-
-	gotValue := reflect.ValueOf(a.got)
-	gotKind := gotValue.Kind()
-
-	if gotKind != reflect.String && gotKind != reflect.Slice {
-		a.errorf("Invalid observed argument type, only string and []byte allowed", want, true)
-		return false
-	}
-
-	wantValue := reflect.ValueOf(want)
-	wantKind := wantValue.Kind()
-
-	if wantKind != reflect.String && wantKind != reflect.Slice {
-		a.errorf("Expected value must be a string or slice of bytes", want, true)
+// Either side may be JSON text as a string, []byte or json.RawMessage, an io.Reader such as an
+// http.Response body, or any other value, which is then JSON-marshalled. Both sides are decoded
+// into a canonical JSON tree before they're compared, so formatting and key order don't matter.
+// On failure, every differing node is listed by its JSON Pointer, followed by both documents.
+// The decoding and comparison can be adjusted with options.
+//
+//	Example:
+//		assert(resp.Body).IsJSONEqualTo(User{Name: "alice"}, assert.StrictEmptyJSON())
+func (a asserter) IsJSONEqualTo(want interface{}, opts ...JSONOption) bool {
+	if a.navigationFailed {
 		return false
 	}
-
-	var gotAsBytes []byte
-	if gotKind == reflect.String {
-		gotAsBytes = []byte(gotValue.String())
-	} else {
-		if gotValue.Type() == reflect.TypeOf([]byte(nil)) {
-			gotAsBytes = gotValue.Bytes()
-		} else {
-			a.errorf("Observed value must be a string or slice of bytes", want, true)
-			return false
-		}
-	}
-	if isEmpty(gotAsBytes) {
-		gotAsBytes = []byte("{}")
-	}
-
-	var wantAsBytes []byte
-	if wantKind == reflect.String {
-		wantAsBytes = []byte(wantValue.String())
-	} else {
-		if wantValue.Type() == reflect.TypeOf([]byte(nil)) {
-			wantAsBytes = wantValue.Bytes()
-		} else {
-			a.errorf("Expected slice must be a slice of bytes", want, true)
-			return false
-		}
-	}
-	if isEmpty(wantAsBytes) {
-		wantAsBytes = []byte("{}")
+	// Nils, including typed nils such as nil byte slices, are equal, unless strict empty handling
+	// makes nil JSON text invalid. Nils selected from JSON documents are JSON nulls, which are
+	// decoded like other values.
+	if cfg, err := newJSONConfig(opts); err == nil && !cfg.strictEmpty &&
+		a.jsonCfg == nil && isNil(a.got) && isNil(want) {
+		return true
 	}
 
-	var got1 interface{}
-	var want1 interface{}
+	gotNil := a.got == nil && a.jsonCfg == nil
 
-	var err error
-	err = json.Unmarshal(gotAsBytes, &got1)
-	if err != nil {
-		a.errorf("Could not JSON-unmarshal observed value", want, true)
-		return false
-	}
-	err = json.Unmarshal(wantAsBytes, &want1)
-	if err != nil {
-		a.errorf("Could not JSON-unmarshal expected value", want, true)
+	if gotNil || want == nil {
+		a.errorf("Invalid argument", want, true)
 		return false
 	}
 
-	if !reflect.DeepEqual(got1, want1) {
-		a.errorf("Values are not equal", want, true)
-		return false
-	}
-	return true
+	return a.compareJSON(want, false, "Observed and expected JSON must be equal", opts)
 }
 
 // IsWantedError asserts the observed value is an error and that it's wanted. If it's not, the function
@@ -437,7 +636,16 @@ func (a asserter) IsJSONEqualTo(want interface{}) bool {
 //		got, err := FuncToTest()
 //		assert(err).IsWantedError(wantErr) // where wantErr is a bool
 //
+// An error interface holding a nil pointer (typed nil) is a non-nil error that's usually returned
+// by mistake, so it makes the assertion fail regardless of wantErr.
 func (a asserter) IsWantedError(wantErr bool) bool {
+	if a.navigationFailed {
+		return false
+	}
+	if isTypedNil(a.got) {
+		a.errorf("Observed error must not be a non-nil interface holding a nil pointer (typed nil)", wantErr, true)
+		return false
+	}
 	if wantErr && isNil(a.got) {
 		a.errorf("Observed value must not be nil", wantErr, true)
 		return false
@@ -468,6 +676,9 @@ func (a asserter) IsWantedError(wantErr bool) bool {
 //	Example 3. Asserts got is a func with a specific signature
 //	assert(got).IsType( func(a, b int) int { return 5 } )
 func (a asserter) IsType(want interface{}) bool {
+	if a.navigationFailed {
+		return false
+	}
 	isType := isType(a.got, want)
 	if !isType {
 		a.errorf("Observed and expected values must be of the same Type", want, true)
@@ -518,6 +729,9 @@ func isChan(arg interface{}) bool {
 //	Example: Asserts *strings.Reader implements io.Reader
 //		assert(strings.NewReader("dummy-str")).Implements((*io.Reader)(nil))
 func (a asserter) Implements(want interface{}) bool {
+	if a.navigationFailed {
+		return false
+	}
 	if isNil(a.got) || want == nil {
 		a.errorf("Observed/Expected value must non-nil", want, true)
 		return false
@@ -391,6 +391,54 @@ func TestIgnoringOrderEqualsElementsIn(t *testing.T) {
 			},
 			want: true,
 		},
+		{
+			name: "should fail when want and get same distinct elements with different multiplicities",
+			args: args{
+				got:  []int{1, 2, 2},
+				want: []int{1, 1, 2},
+			},
+			want: false,
+		},
+		{
+			name: "should pass when want and get same elements with same multiplicities not in order",
+			args: args{
+				got:  []int{2, 1, 2, 1},
+				want: []int{1, 1, 2, 2},
+			},
+			want: true,
+		},
+		{
+			name: "should fail when want slice but get superset",
+			args: args{
+				got:  []string{"a", "b", "c", "d"},
+				want: []string{"a", "b", "c"},
+			},
+			want: false,
+		},
+		{
+			name: "should pass when want and get slices of slices not in order",
+			args: args{
+				got:  [][]int{{3}, {1, 2}},
+				want: [][]int{{1, 2}, {3}},
+			},
+			want: true,
+		},
+		{
+			name: "should fail when want and get different slices of slices",
+			args: args{
+				got:  [][]int{{3}, {1, 2}},
+				want: [][]int{{1, 2}, {4}},
+			},
+			want: false,
+		},
+		{
+			name: "should pass when want and get slices of maps not in order",
+			args: args{
+				got:  []map[string]int{{"b": 2}, {"a": 1}},
+				want: []map[string]int{{"a": 1}, {"b": 2}},
+			},
+			want: true,
+		},
 	}
 
 	t.Parallel()
@@ -411,6 +459,59 @@ func TestIgnoringOrderEqualsElementsIn(t *testing.T) {
 	}
 }
 
+func TestIgnoringOrderEqualsElementsInReportsMissingAndUnexpected(t *testing.T) {
+	// Given
+	assert := New(t)
+	dummyT := &fakeT{}
+	dummyAssert := New(dummyT)
+
+	// When
+	dummyAssert([]int{1, 2, 2, 4}).IgnoringOrderEqualsElementsIn([]int{1, 1, 2, 3})
+
+	// Then
+	assert(len(dummyT.logs)).Equals(1)
+	assert(strings.Contains(dummyT.logs[0], "Missing elements: [1, 3]")).IsTrue()
+	assert(strings.Contains(dummyT.logs[0], "Unexpected elements: [2, 4]")).IsTrue()
+}
+
+func TestIgnoringOrderEqualsElementsInReportsNilElements(t *testing.T) {
+	// Given
+	assert := New(t)
+	dummyT := &fakeT{}
+	dummyAssert := New(dummyT)
+
+	// When
+	got := dummyAssert([]interface{}{1, nil}).IgnoringOrderEqualsElementsIn([]interface{}{nil, 2})
+
+	// Then
+	assert(got).IsFalse()
+	assert(len(dummyT.logs)).Equals(1)
+	assert(strings.Contains(dummyT.logs[0], "Missing elements: [2]")).IsTrue()
+	assert(strings.Contains(dummyT.logs[0], "Unexpected elements: [1]")).IsTrue()
+}
+
+func TestIgnoringOrderEqualsElementsInComparesCyclicElements(t *testing.T) {
+	// Given
+	assert := New(t)
+	dummyT := &fakeT{}
+	dummyAssert := New(dummyT)
+	type node struct {
+		Next  *node
+		Value int
+	}
+	// wantB and gotB differ, since x and y do, but they're equal while x and y are assumed to be
+	x, y := &node{Value: 1}, &node{Value: 2}
+	wantB, gotB := &node{Next: x, Value: 3}, &node{Next: y, Value: 3}
+	x.Next, y.Next = wantB, gotB
+
+	// When
+	got := dummyAssert([]interface{}{y, gotB, x}).IgnoringOrderEqualsElementsIn([]interface{}{x, wantB, y})
+
+	// Then
+	assert(equals(gotB, wantB)).IsFalse()
+	assert(got).IsFalse()
+}
+
 func TestIsEmpty(t *testing.T) {
 	nonEmptyChan := make(chan int, 10)
 	nonEmptyChan <- 4
@@ -928,6 +1029,100 @@ func TestIsNil(t *testing.T) {
 	}
 }
 
+func TestIsNilInterface(t *testing.T) {
+	type args struct {
+		got interface{}
+	}
+	tests := []struct {
+		name string
+		args args
+		want bool
+	}{
+		{
+			name: "should return true when get nil interface",
+			args: args{got: zero["error"]},
+			want: true,
+		},
+		{
+			name: "should return false when get typed nil error",
+			args: args{got: error((*dummyError)(nil))},
+			want: false,
+		},
+		{
+			name: "should return false when get nil slice",
+			args: args{got: zero["slice"]},
+			want: false,
+		},
+		{
+			name: "should return false when get non-nil value",
+			args: args{got: nonZero["error"]},
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Given
+			assert := NewFatal(t)
+			dummyT := &fakeT{}
+			dummyAssert := New(dummyT)
+
+			// When
+			got := dummyAssert(tt.args.got).IsNilInterface()
+
+			// Then
+			assert(got).Equals(tt.want)
+			assert(dummyT.failed).Equals(!tt.want)
+		})
+	}
+}
+
+func TestIsTypedNil(t *testing.T) {
+	type args struct {
+		got interface{}
+	}
+	tests := []struct {
+		name string
+		args args
+		want bool
+	}{
+		{
+			name: "should return true when get typed nil error",
+			args: args{got: error((*dummyError)(nil))},
+			want: true,
+		},
+		{
+			name: "should return true when get nil pointer",
+			args: args{got: zero["ptr"]},
+			want: true,
+		},
+		{
+			name: "should return false when get nil interface",
+			args: args{got: zero["error"]},
+			want: false,
+		},
+		{
+			name: "should return false when get non-nil error",
+			args: args{got: nonZero["error"]},
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Given
+			assert := NewFatal(t)
+			dummyT := &fakeT{}
+			dummyAssert := New(dummyT)
+
+			// When
+			got := dummyAssert(tt.args.got).IsTypedNil()
+
+			// Then
+			assert(got).Equals(tt.want)
+			assert(dummyT.failed).Equals(!tt.want)
+		})
+	}
+}
+
 func TestIsNotEmpty(t *testing.T) {
 	nonEmptyChan := make(chan int, 10)
 	nonEmptyChan <- 4
@@ -1971,6 +2166,22 @@ func TestIsWantedError(t *testing.T) {
 			},
 			want: false,
 		},
+		{
+			name: "should return false when not wantErr and get typed nil error",
+			args: args{
+				got:     error((*dummyError)(nil)),
+				wantErr: false,
+			},
+			want: false,
+		},
+		{
+			name: "should return false when wantErr and get typed nil error",
+			args: args{
+				got:     error((*dummyError)(nil)),
+				wantErr: true,
+			},
+			want: false,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -2231,3 +2442,94 @@ func TestImplements(t *testing.T) {
 		})
 	}
 }
+
+// fakeT is a TestingT that records failures instead of reporting them to a test runner.
+type fakeT struct {
+	failed bool
+	fatal  bool
+	logs   []string
+}
+
+func (f *fakeT) Fail() {
+	f.failed = true
+}
+
+func (f *fakeT) Fatal(args ...interface{}) {
+	f.failed = true
+	f.fatal = true
+	f.logs = append(f.logs, fmt.Sprint(args...))
+}
+
+func (f *fakeT) Log(args ...interface{}) {
+	f.logs = append(f.logs, fmt.Sprint(args...))
+}
+
+func TestNewWithTestingT(t *testing.T) {
+	type args struct {
+		newFn func(t TestingT) func(got interface{}) asserter
+		got   interface{}
+		want  interface{}
+	}
+	tests := []struct {
+		name      string
+		args      args
+		wantFatal bool
+		wantLogs  int
+		want      bool
+	}{
+		{
+			name: "should not report anything when assertion passes",
+			args: args{
+				newFn: New,
+				got:   nonZero["int"],
+				want:  nonZero["int"],
+			},
+			want: true,
+		},
+		{
+			name: "should call Fail and Log when non-fatal assertion fails",
+			args: args{
+				newFn: New,
+				got:   nonZero["int"],
+				want:  nonZero["string"],
+			},
+			wantLogs: 1,
+			want:     false,
+		},
+		{
+			name: "should call Fatal when fatal assertion fails",
+			args: args{
+				newFn: NewFatal,
+				got:   nonZero["int"],
+				want:  nonZero["string"],
+			},
+			wantFatal: true,
+			wantLogs:  1,
+			want:      false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Given
+			assert := NewFatal(t)
+			dummyT := &fakeT{}
+			dummyAssert := tt.args.newFn(dummyT)
+
+			// When
+			got := dummyAssert(tt.args.got).Equals(tt.args.want)
+
+			// Then
+			assert(got).Equals(tt.want)
+			assert(dummyT.failed).Equals(!tt.want)
+			assert(dummyT.fatal).Equals(tt.wantFatal)
+			assert(len(dummyT.logs)).Equals(tt.wantLogs)
+		})
+	}
+}
+
+func BenchmarkEquals(b *testing.B) {
+	assert := New(b)
+	for i := 0; i < b.N; i++ {
+		assert(nonZero["struct"]).Equals(nonZero["struct"])
+	}
+}
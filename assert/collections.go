@@ -0,0 +1,295 @@
+package assert
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// collectionElements returns the elements of a slice or array, or the keys of a map.
+func collectionElements(collection interface{}) ([]interface{}, error) {
+	if collection == nil {
+		return nil, errors.New("nil isn't a collection")
+	}
+	value := reflect.ValueOf(collection)
+	switch value.Kind() {
+	case reflect.Array, reflect.Slice:
+		elems := make([]interface{}, 0, value.Len())
+		for i := 0; i < value.Len(); i++ {
+			elems = append(elems, value.Index(i).Interface())
+		}
+		return elems, nil
+	case reflect.Map:
+		elems := make([]interface{}, 0, value.Len())
+		for _, key := range sortedKeys(value) {
+			elems = append(elems, key.Interface())
+		}
+		return elems, nil
+	default:
+		return nil, fmt.Errorf("values of type %T aren't collections, only slices, arrays and maps are", collection)
+	}
+}
+
+// containsElement reports whether the collection contains an element equal to 'want'. If both
+// are strings, []byte or fmt.Stringer values, it reports whether 'want' is a substring instead,
+// but slices, arrays and maps are always searched for elements, even if they're also
+// fmt.Stringer values. For maps, the keys are searched. The second return value describes where
+// 'want' was found in strings, slices and arrays.
+func containsElement(collection, want interface{}, cfg equalConfig) (bool, string, error) {
+	if !isCollection(collection) {
+		if text, ok := toText(collection); ok {
+			if substr, ok := toText(want); ok {
+				i := strings.Index(text, substr)
+				return i >= 0, fmt.Sprintf("Found at index: %d", i), nil
+			}
+		}
+	}
+	elems, err := collectionElements(collection)
+	if err != nil {
+		return false, "", err
+	}
+	i := indexOf(elems, want, cfg)
+	if i < 0 || reflect.TypeOf(collection).Kind() == reflect.Map {
+		return i >= 0, "", nil
+	}
+	return true, fmt.Sprintf("Found at index: %d", i), nil
+}
+
+// isCollection reports whether the value is a slice, array or map, other than a byte slice.
+func isCollection(v interface{}) bool {
+	if v == nil {
+		return false
+	}
+	typ := reflect.TypeOf(v)
+	switch typ.Kind() {
+	case reflect.Array, reflect.Map:
+		return true
+	case reflect.Slice:
+		return typ.Elem().Kind() != reflect.Uint8
+	default:
+		return false
+	}
+}
+
+func indexOf(elems []interface{}, want interface{}, cfg equalConfig) int {
+	for i, elem := range elems {
+		if equalsWith(elem, want, cfg) {
+			return i
+		}
+	}
+	return -1
+}
+
+// Contains asserts the observed value contains the 'want' argument. Slices and arrays must have
+// an element equal to it and maps must have a key equal to it, where elements are compared like
+// in the Equals method, even if they're also fmt.Stringer values. Strings, []byte and other
+// fmt.Stringer values must contain it as a substring, if it's also a string, []byte or
+// fmt.Stringer. If not, the function under test is marked as
+// having failed.
+//
+//	Example:
+//		assert([]string{"a", "b"}).Contains("b")
+//		assert("hello world").Contains("world")
+func (a asserter) Contains(want interface{}) bool {
+	if a.navigationFailed {
+		return false
+	}
+	want, cfg := a.jsonElement(want)
+	found, _, err := containsElement(a.got, want, cfg)
+	if err != nil {
+		a.errorf(fmt.Sprintf("Invalid argument: %v", err), want, true)
+		return false
+	}
+	if !found {
+		a.errorf("Observed value must contain expected value", want, true)
+		return false
+	}
+	return true
+}
+
+// NotContains asserts the observed value doesn't contain the 'want' argument. It performs the
+// same check as the Contains method, but inverts the result. If it does, the function under test
+// is marked as having failed.
+func (a asserter) NotContains(want interface{}) bool {
+	if a.navigationFailed {
+		return false
+	}
+	want, cfg := a.jsonElement(want)
+	found, location, err := containsElement(a.got, want, cfg)
+	if err != nil {
+		a.errorf(fmt.Sprintf("Invalid argument: %v", err), want, true)
+		return false
+	}
+	if found {
+		var details []string
+		if location != "" {
+			details = append(details, location)
+		}
+		a.errorf("Observed value must not contain expected value", want, true, details...)
+		return false
+	}
+	return true
+}
+
+// ContainsValue asserts the observed value is a map with a value equal to the 'want' argument.
+// Values are compared like in the Equals method. If not, the function under test is marked as
+// having failed.
+func (a asserter) ContainsValue(want interface{}) bool {
+	if a.navigationFailed {
+		return false
+	}
+	if a.got == nil || reflect.TypeOf(a.got).Kind() != reflect.Map {
+		a.errorf("Observed value must be a map", want, true)
+		return false
+	}
+	want, cfg := a.jsonElement(want)
+	iter := reflect.ValueOf(a.got).MapRange()
+	for iter.Next() {
+		if equalsWith(iter.Value().Interface(), want, cfg) {
+			return true
+		}
+	}
+	a.errorf("Observed map must contain expected value", want, true)
+	return false
+}
+
+// ContainsAll asserts the observed value contains all the 'want' arguments. See the Contains
+// method for how the observed value is searched. If any is missing, the function under test is
+// marked as having failed.
+func (a asserter) ContainsAll(want ...interface{}) bool {
+	if a.navigationFailed {
+		return false
+	}
+	var missing []interface{}
+	for _, elem := range want {
+		elem, cfg := a.jsonElement(elem)
+		found, _, err := containsElement(a.got, elem, cfg)
+		if err != nil {
+			a.errorf(fmt.Sprintf("Invalid argument: %v", err), want, true)
+			return false
+		}
+		if !found {
+			missing = append(missing, elem)
+		}
+	}
+	if len(missing) > 0 {
+		a.errorf("Observed value must contain all expected values", want, true,
+			fmt.Sprintf("Not found: %s", formatElements(missing)))
+		return false
+	}
+	return true
+}
+
+// ContainsAny asserts the observed value contains at least one of the 'want' arguments. See the
+// Contains method for how the observed value is searched. If it contains none of them, the
+// function under test is marked as having failed.
+func (a asserter) ContainsAny(want ...interface{}) bool {
+	if a.navigationFailed {
+		return false
+	}
+	for _, elem := range want {
+		elem, cfg := a.jsonElement(elem)
+		found, _, err := containsElement(a.got, elem, cfg)
+		if err != nil {
+			a.errorf(fmt.Sprintf("Invalid argument: %v", err), want, true)
+			return false
+		}
+		if found {
+			return true
+		}
+	}
+	a.errorf("Observed value must contain at least one expected value", want, true,
+		fmt.Sprintf("Not found: %s", formatElements(want)))
+	return false
+}
+
+// HasLen asserts the observed value has the length 'want'. Slices, arrays, maps, strings and
+// channels are supported, where the length of a channel is the number of buffered elements. If the
+// length differs, the function under test is marked as having failed.
+func (a asserter) HasLen(want int) bool {
+	if a.navigationFailed {
+		return false
+	}
+	if a.got == nil {
+		a.errorf("Invalid argument: nil has no length", want, true)
+		return false
+	}
+	value := reflect.ValueOf(a.got)
+	switch value.Kind() {
+	case reflect.Array, reflect.Chan, reflect.Map, reflect.Slice, reflect.String:
+	default:
+		a.errorf(fmt.Sprintf("Invalid argument: values of type %T have no length", a.got), want, true)
+		return false
+	}
+	if got := value.Len(); got != want {
+		a.errorf("Observed value must have expected length", want, true,
+			fmt.Sprintf("Length: want %d, got %d", want, got))
+		return false
+	}
+	return true
+}
+
+// IsSubsetOf asserts every element of the observed value is found in the 'want' argument. Both
+// must be slices, arrays or maps, where the keys of maps are used. Elements are compared like in
+// the Equals method. If any element isn't found, the function under test is marked as having
+// failed.
+//
+//	Example:
+//		assert([]string{"a", "c"}).IsSubsetOf([]string{"a", "b", "c"})
+func (a asserter) IsSubsetOf(want interface{}) bool {
+	if a.navigationFailed {
+		return false
+	}
+	want, cfg := a.jsonElement(want)
+	gotElems, err := collectionElements(a.got)
+	if err != nil {
+		a.errorf(fmt.Sprintf("Invalid argument: %v", err), want, true)
+		return false
+	}
+	wantElems, err := collectionElements(want)
+	if err != nil {
+		a.errorf(fmt.Sprintf("Invalid argument: %v", err), want, true)
+		return false
+	}
+
+	var notFound []interface{}
+	for _, elem := range gotElems {
+		if indexOf(wantElems, elem, cfg) < 0 {
+			notFound = append(notFound, elem)
+		}
+	}
+	if len(notFound) > 0 {
+		a.errorf("Observed elements must be a subset of expected elements", want, true,
+			fmt.Sprintf("Not found: %s", formatElements(notFound)))
+		return false
+	}
+	return true
+}
+
+// HasUniqueElements asserts the observed value is a slice or array without duplicate elements.
+// Elements are compared like in the Equals method. If there are duplicates, the function under
+// test is marked as having failed.
+func (a asserter) HasUniqueElements() bool {
+	if a.navigationFailed {
+		return false
+	}
+	if !isList(a.got) {
+		a.errorf("Invalid argument: observed value must be a slice or array", nil, false)
+		return false
+	}
+	elems, _ := collectionElements(a.got)
+
+	var duplicates []string
+	for i, elem := range elems {
+		if first := indexOf(elems[:i], elem, equalConfig{}); first >= 0 {
+			duplicates = append(duplicates, fmt.Sprintf("[%d] duplicates [%d]: %s",
+				i, first, formatValue(reflect.ValueOf(elem))))
+		}
+	}
+	if len(duplicates) > 0 {
+		a.errorf("Observed elements must be unique", nil, false, duplicates...)
+		return false
+	}
+	return true
+}
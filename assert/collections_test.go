@@ -0,0 +1,487 @@
+package assert
+
+import (
+	"strings"
+	"testing"
+)
+
+// stringerTags is a slice type with a String method, which must still be searched for elements.
+type stringerTags []string
+
+func (t stringerTags) String() string {
+	return strings.Join(t, "")
+}
+
+func TestContains(t *testing.T) {
+	type args struct {
+		got  interface{}
+		want interface{}
+	}
+	tests := []struct {
+		name           string
+		args           args
+		want           bool
+		wantTestFailed bool
+	}{
+		{
+			name:           "should pass when slice contains element",
+			args:           args{got: []int{1, 2, 3}, want: 2},
+			want:           true,
+			wantTestFailed: false,
+		},
+		{
+			name:           "should fail when slice doesn't contain element",
+			args:           args{got: []int{1, 2, 3}, want: 4},
+			want:           false,
+			wantTestFailed: true,
+		},
+		{
+			name:           "should fail when stringer slice only contains element as substring",
+			args:           args{got: stringerTags{"ab", "c"}, want: "b"},
+			want:           false,
+			wantTestFailed: true,
+		},
+		{
+			name:           "should pass when stringer slice contains element",
+			args:           args{got: stringerTags{"ab", "c"}, want: "ab"},
+			want:           true,
+			wantTestFailed: false,
+		},
+		{
+			name:           "should pass when array contains struct element",
+			args:           args{got: [2]struct{ i int }{{1}, {2}}, want: struct{ i int }{2}},
+			want:           true,
+			wantTestFailed: false,
+		},
+		{
+			name:           "should pass when slice of slices contains slice",
+			args:           args{got: [][]int{{1}, {2, 3}}, want: []int{2, 3}},
+			want:           true,
+			wantTestFailed: false,
+		},
+		{
+			name:           "should pass when map contains key",
+			args:           args{got: map[string]int{"a": 1}, want: "a"},
+			want:           true,
+			wantTestFailed: false,
+		},
+		{
+			name:           "should fail when map only contains value",
+			args:           args{got: map[string]string{"a": "b"}, want: "b"},
+			want:           false,
+			wantTestFailed: true,
+		},
+		{
+			name:           "should pass when byte slice contains byte",
+			args:           args{got: []byte("abc"), want: byte('b')},
+			want:           true,
+			wantTestFailed: false,
+		},
+		{
+			name:           "should fail when get non-collection",
+			args:           args{got: nonZero["int"], want: 3},
+			want:           false,
+			wantTestFailed: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Given
+			assert := New(t)
+			dummyT := &fakeT{}
+			dummyAssert := New(dummyT)
+
+			// When
+			got := dummyAssert(tt.args.got).Contains(tt.args.want)
+
+			// Then
+			assert(got).Equals(tt.want)
+			assert(dummyT.failed).Equals(tt.wantTestFailed)
+		})
+	}
+}
+
+func TestNotContains(t *testing.T) {
+	type args struct {
+		got  interface{}
+		want interface{}
+	}
+	tests := []struct {
+		name           string
+		args           args
+		want           bool
+		wantTestFailed bool
+		wantMessage    []string
+	}{
+		{
+			name:           "should pass when slice doesn't contain unwanted element",
+			args:           args{got: []int{1, 2, 3}, want: 4},
+			want:           true,
+			wantTestFailed: false,
+		},
+		{
+			name:           "should fail when slice contains unwanted element",
+			args:           args{got: []int{1, 2, 3}, want: 3},
+			want:           false,
+			wantTestFailed: true,
+			wantMessage:    []string{"Found at index: 2"},
+		},
+		{
+			name:           "should report index when string contains unwanted substring",
+			args:           args{got: "hello world", want: "world"},
+			want:           false,
+			wantTestFailed: true,
+			wantMessage:    []string{"Found at index: 6"},
+		},
+		{
+			name:           "should report index when slice contains unwanted string",
+			args:           args{got: []string{"a", "b"}, want: "b"},
+			want:           false,
+			wantTestFailed: true,
+			wantMessage:    []string{"Found at index: 1"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Given
+			assert := New(t)
+			dummyT := &fakeT{}
+			dummyAssert := New(dummyT)
+
+			// When
+			got := dummyAssert(tt.args.got).NotContains(tt.args.want)
+
+			// Then
+			assert(got).Equals(tt.want)
+			assert(dummyT.failed).Equals(tt.wantTestFailed)
+			for _, want := range tt.wantMessage {
+				assert(strings.Contains(strings.Join(dummyT.logs, "\n"), want)).IsTrue()
+			}
+		})
+	}
+}
+
+func TestContainsValue(t *testing.T) {
+	type args struct {
+		got  interface{}
+		want interface{}
+	}
+	tests := []struct {
+		name           string
+		args           args
+		want           bool
+		wantTestFailed bool
+	}{
+		{
+			name:           "should pass when map contains value",
+			args:           args{got: map[string]string{"a": "b"}, want: "b"},
+			want:           true,
+			wantTestFailed: false,
+		},
+		{
+			name:           "should fail when map doesn't contain value",
+			args:           args{got: map[string]string{"a": "b"}, want: "a"},
+			want:           false,
+			wantTestFailed: true,
+		},
+		{
+			name:           "should fail when want value but get slice",
+			args:           args{got: []string{"a"}, want: "a"},
+			want:           false,
+			wantTestFailed: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Given
+			assert := New(t)
+			dummyT := &fakeT{}
+			dummyAssert := New(dummyT)
+
+			// When
+			got := dummyAssert(tt.args.got).ContainsValue(tt.args.want)
+
+			// Then
+			assert(got).Equals(tt.want)
+			assert(dummyT.failed).Equals(tt.wantTestFailed)
+		})
+	}
+}
+
+func TestContainsAll(t *testing.T) {
+	type args struct {
+		got  interface{}
+		want []interface{}
+	}
+	tests := []struct {
+		name           string
+		args           args
+		want           bool
+		wantTestFailed bool
+		wantMessage    []string
+	}{
+		{
+			name:           "should pass when slice contains all elements",
+			args:           args{got: []string{"a", "b", "c"}, want: []interface{}{"c", "a"}},
+			want:           true,
+			wantTestFailed: false,
+		},
+		{
+			name:           "should fail when slice doesn't contain all elements",
+			args:           args{got: []string{"a", "b", "c"}, want: []interface{}{"c", "d"}},
+			want:           false,
+			wantTestFailed: true,
+			wantMessage:    []string{`Not found: ["d"]`},
+		},
+		{
+			name:           "should report every missing element",
+			args:           args{got: []string{"a", "b"}, want: []interface{}{"a", "c", "d"}},
+			want:           false,
+			wantTestFailed: true,
+			wantMessage:    []string{`Not found: ["c", "d"]`},
+		},
+		{
+			name:           "should pass when string contains all substrings",
+			args:           args{got: "hello world", want: []interface{}{"hello", "world"}},
+			want:           true,
+			wantTestFailed: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Given
+			assert := New(t)
+			dummyT := &fakeT{}
+			dummyAssert := New(dummyT)
+
+			// When
+			got := dummyAssert(tt.args.got).ContainsAll(tt.args.want...)
+
+			// Then
+			assert(got).Equals(tt.want)
+			assert(dummyT.failed).Equals(tt.wantTestFailed)
+			for _, want := range tt.wantMessage {
+				assert(strings.Contains(strings.Join(dummyT.logs, "\n"), want)).IsTrue()
+			}
+		})
+	}
+}
+
+func TestContainsAny(t *testing.T) {
+	type args struct {
+		got  interface{}
+		want []interface{}
+	}
+	tests := []struct {
+		name           string
+		args           args
+		want           bool
+		wantTestFailed bool
+		wantMessage    []string
+	}{
+		{
+			name:           "should pass when slice contains any element",
+			args:           args{got: []string{"a", "b", "c"}, want: []interface{}{"x", "b"}},
+			want:           true,
+			wantTestFailed: false,
+		},
+		{
+			name:           "should fail when slice contains none of the elements",
+			args:           args{got: []string{"a", "b", "c"}, want: []interface{}{"x", "y"}},
+			want:           false,
+			wantTestFailed: true,
+			wantMessage:    []string{`Not found: ["x", "y"]`},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Given
+			assert := New(t)
+			dummyT := &fakeT{}
+			dummyAssert := New(dummyT)
+
+			// When
+			got := dummyAssert(tt.args.got).ContainsAny(tt.args.want...)
+
+			// Then
+			assert(got).Equals(tt.want)
+			assert(dummyT.failed).Equals(tt.wantTestFailed)
+			for _, want := range tt.wantMessage {
+				assert(strings.Contains(strings.Join(dummyT.logs, "\n"), want)).IsTrue()
+			}
+		})
+	}
+}
+
+func TestHasLen(t *testing.T) {
+	buffered := make(chan int, 3)
+	buffered <- 1
+	buffered <- 2
+
+	type args struct {
+		got  interface{}
+		want int
+	}
+	tests := []struct {
+		name           string
+		args           args
+		want           bool
+		wantTestFailed bool
+		wantMessage    []string
+	}{
+		{
+			name:           "should pass when slice has length",
+			args:           args{got: []int{1, 2, 3}, want: 3},
+			want:           true,
+			wantTestFailed: false,
+		},
+		{
+			name:           "should fail when map has other length",
+			args:           args{got: map[string]int{"a": 1}, want: 2},
+			want:           false,
+			wantTestFailed: true,
+			wantMessage:    []string{"Length: want 2, got 1"},
+		},
+		{
+			name:           "should pass when string has length in bytes",
+			args:           args{got: "héllo", want: 6},
+			want:           true,
+			wantTestFailed: false,
+		},
+		{
+			name:           "should pass when channel has buffered length",
+			args:           args{got: buffered, want: 2},
+			want:           true,
+			wantTestFailed: false,
+		},
+		{
+			name:           "should fail when get value without length",
+			args:           args{got: nonZero["int"], want: 0},
+			want:           false,
+			wantTestFailed: true,
+			wantMessage:    []string{"values of type int have no length"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Given
+			assert := New(t)
+			dummyT := &fakeT{}
+			dummyAssert := New(dummyT)
+
+			// When
+			got := dummyAssert(tt.args.got).HasLen(tt.args.want)
+
+			// Then
+			assert(got).Equals(tt.want)
+			assert(dummyT.failed).Equals(tt.wantTestFailed)
+			for _, want := range tt.wantMessage {
+				assert(strings.Contains(strings.Join(dummyT.logs, "\n"), want)).IsTrue()
+			}
+		})
+	}
+}
+
+func TestIsSubsetOf(t *testing.T) {
+	type args struct {
+		got  interface{}
+		want interface{}
+	}
+	tests := []struct {
+		name           string
+		args           args
+		want           bool
+		wantTestFailed bool
+		wantMessage    []string
+	}{
+		{
+			name:           "should pass when slice is subset of array",
+			args:           args{got: []string{"c", "a"}, want: [3]string{"a", "b", "c"}},
+			want:           true,
+			wantTestFailed: false,
+		},
+		{
+			name:           "should fail when slice isn't subset",
+			args:           args{got: []string{"c", "d"}, want: []string{"a", "b", "c"}},
+			want:           false,
+			wantTestFailed: true,
+			wantMessage:    []string{`Not found: ["d"]`},
+		},
+		{
+			name:           "should pass when map keys are subset of map keys",
+			args:           args{got: map[string]int{"a": 1}, want: map[string]int{"a": 2, "b": 3}},
+			want:           true,
+			wantTestFailed: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Given
+			assert := New(t)
+			dummyT := &fakeT{}
+			dummyAssert := New(dummyT)
+
+			// When
+			got := dummyAssert(tt.args.got).IsSubsetOf(tt.args.want)
+
+			// Then
+			assert(got).Equals(tt.want)
+			assert(dummyT.failed).Equals(tt.wantTestFailed)
+			for _, want := range tt.wantMessage {
+				assert(strings.Contains(strings.Join(dummyT.logs, "\n"), want)).IsTrue()
+			}
+		})
+	}
+}
+
+func TestHasUniqueElements(t *testing.T) {
+	type args struct {
+		got interface{}
+	}
+	tests := []struct {
+		name           string
+		args           args
+		want           bool
+		wantTestFailed bool
+		wantMessage    []string
+	}{
+		{
+			name:           "should pass when slice has unique elements",
+			args:           args{got: []int{1, 2, 3}},
+			want:           true,
+			wantTestFailed: false,
+		},
+		{
+			name:           "should fail when slice has duplicate elements",
+			args:           args{got: [][]int{{1}, {2}, {1}}},
+			want:           false,
+			wantTestFailed: true,
+			wantMessage:    []string{"[2] duplicates [0]"},
+		},
+		{
+			name:           "should fail when want unique elements but get map",
+			args:           args{got: map[string]int{"a": 1}},
+			want:           false,
+			wantTestFailed: true,
+			wantMessage:    []string{"observed value must be a slice or array"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Given
+			assert := New(t)
+			dummyT := &fakeT{}
+			dummyAssert := New(dummyT)
+
+			// When
+			got := dummyAssert(tt.args.got).HasUniqueElements()
+
+			// Then
+			assert(got).Equals(tt.want)
+			assert(dummyT.failed).Equals(tt.wantTestFailed)
+			for _, want := range tt.wantMessage {
+				assert(strings.Contains(strings.Join(dummyT.logs, "\n"), want)).IsTrue()
+			}
+		})
+	}
+}
@@ -0,0 +1,453 @@
+package assert
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+)
+
+// maxReportedDifferences limits the number of differences included in a failure message.
+const maxReportedDifferences = 50
+
+// difference describes a single path at which two values differ.
+type difference struct {
+	path string
+	want string
+	got  string
+	// missing is set if the path only exists in the expected value, in which case got is unset
+	missing bool
+	// unexpected is set if the path only exists in the observed value, in which case want is unset
+	unexpected bool
+}
+
+func (d difference) String() string {
+	path := d.path
+	if path == "" {
+		path = "(root)"
+	}
+	switch {
+	case d.missing:
+		return fmt.Sprintf("%s: missing, want %s", path, d.want)
+	case d.unexpected:
+		return fmt.Sprintf("%s: unexpected, got %s", path, d.got)
+	}
+	return fmt.Sprintf("%s: want %s, got %s", path, d.want, d.got)
+}
+
+// valuePath is the location of a value within the root value being compared.
+type valuePath struct {
+	// display is the path shown in failure messages, e.g. .Orders[3].Items[1].Price
+	display string
+	// fields is the path of struct field names and string map keys leading to the value,
+	// without slice and array indexes, e.g. Orders.Items.Price
+	fields string
+}
+
+func (p valuePath) field(name string) valuePath {
+	return valuePath{display: p.display + "." + name, fields: joinFields(p.fields, name)}
+}
+
+func (p valuePath) index(i int) valuePath {
+	return valuePath{display: fmt.Sprintf("%s[%d]", p.display, i), fields: p.fields}
+}
+
+func (p valuePath) key(k reflect.Value) valuePath {
+	next := valuePath{display: fmt.Sprintf("%s[%s]", p.display, formatValue(k)), fields: p.fields}
+	if k.Kind() == reflect.String {
+		next.fields = joinFields(p.fields, k.String())
+	}
+	return next
+}
+
+func joinFields(path, name string) string {
+	if path == "" {
+		return name
+	}
+	return path + "." + name
+}
+
+// visit identifies a pair of references that are being compared. It's used to detect cycles.
+type visit struct {
+	want uintptr
+	got  uintptr
+	typ  reflect.Type
+}
+
+// comparison identifies a pair of references that has been compared. The path of field names
+// is only part of it if fields are ignored, since the outcome then depends on the path.
+type comparison struct {
+	visit
+	// length is the length of compared slices, which may share their first element
+	length int
+	fields string
+}
+
+// differ recursively walks two values and records every path at which they differ. Without
+// options, its notion of equality is the same as reflect.DeepEqual's.
+type differ struct {
+	cfg   equalConfig
+	diffs []difference
+	// visited holds the pairs of references being walked on the current path
+	visited map[visit]bool
+	// compared holds whether the pairs of references that have been walked are equal, so
+	// references shared by several paths are only compared once
+	compared map[comparison]bool
+	// cycles is the number of times a pair was found to be walked already on the current path
+	cycles int
+	// firstOnly stops the walk at the first difference, when only equality is of interest
+	firstOnly bool
+	// partial makes zero values in 'want' match any value, and ignores map keys only in 'got'
+	partial bool
+}
+
+func newDiffer(cfg equalConfig) differ {
+	return differ{cfg: cfg, visited: make(map[visit]bool), compared: make(map[comparison]bool)}
+}
+
+// diff returns the differences between want and got. Nil is returned when they're equal.
+func diff(want, got interface{}, cfg equalConfig) []difference {
+	d := newDiffer(cfg)
+	d.walk(valuePath{}, reflect.ValueOf(want), reflect.ValueOf(got))
+	return d.diffs
+}
+
+// equalsWith reports whether got and want are equal according to the configuration.
+func equalsWith(got, want interface{}, cfg equalConfig) bool {
+	d := newDiffer(cfg)
+	d.firstOnly = true
+	d.walk(valuePath{}, reflect.ValueOf(want), reflect.ValueOf(got))
+	return len(d.diffs) == 0
+}
+
+// partialDiff returns the differences between want and got, where zero values in want, at any
+// depth, are considered to match any value. Nil is returned when got matches want.
+func partialDiff(want, got interface{}, cfg equalConfig) []difference {
+	d := newDiffer(cfg)
+	d.partial = true
+	wantValue, gotValue := reflect.ValueOf(want), reflect.ValueOf(got)
+	// Zero values only match any value below the root, since the root values must be of the same
+	// type
+	switch {
+	case !wantValue.IsValid():
+	case !gotValue.IsValid():
+		d.report(valuePath{}, wantValue, gotValue)
+		return d.diffs
+	case wantValue.Type() != gotValue.Type():
+		d.reportTypes(valuePath{}, wantValue, gotValue)
+		return d.diffs
+	}
+	d.walk(valuePath{}, wantValue, gotValue)
+	return d.diffs
+}
+
+func (d *differ) done() bool {
+	return d.firstOnly && len(d.diffs) > 0
+}
+
+// reportUndescribed reports a difference without formatting the values, if only equality is of
+// interest. It returns false otherwise.
+func (d *differ) reportUndescribed(path valuePath) bool {
+	if d.firstOnly {
+		d.diffs = append(d.diffs, difference{path: path.display})
+	}
+	return d.firstOnly
+}
+
+func (d *differ) report(path valuePath, want, got reflect.Value) {
+	if d.reportUndescribed(path) {
+		return
+	}
+	d.diffs = append(d.diffs, difference{path: path.display, want: formatValue(want), got: formatValue(got)})
+}
+
+// reportTypes reports values of different types.
+func (d *differ) reportTypes(path valuePath, want, got reflect.Value) {
+	if d.reportUndescribed(path) {
+		return
+	}
+	d.diffs = append(d.diffs, difference{
+		path: path.display,
+		want: fmt.Sprintf("%s (%s)", formatValue(want), want.Type()),
+		got:  fmt.Sprintf("%s (%s)", formatValue(got), got.Type()),
+	})
+}
+
+// reportMissing reports a path that only exists in one of the values, the other one being invalid.
+func (d *differ) reportMissing(path valuePath, want, got reflect.Value) {
+	if d.reportUndescribed(path) {
+		return
+	}
+	if !want.IsValid() {
+		d.diffs = append(d.diffs, difference{path: path.display, got: formatValue(got), unexpected: true})
+		return
+	}
+	d.diffs = append(d.diffs, difference{path: path.display, want: formatValue(want), missing: true})
+}
+
+// entered is a pair of references being walked, along with the state of the walk when it was
+// entered.
+type entered struct {
+	comparison
+	// reported is the number of differences reported before the pair was entered
+	reported int
+	// cycles is the number of cycles found before the pair was entered
+	cycles int
+}
+
+// enter marks the pair of references as being walked. False is returned if the pair is already
+// being walked further up the current path, i.e. if the values are cyclic, or if it has already
+// been compared at an equivalent path. In the latter case, its differences were reported at the
+// first path.
+func (d *differ) enter(path valuePath, want, got reflect.Value) (entered, bool) {
+	c := comparison{visit: visit{want: want.Pointer(), got: got.Pointer(), typ: want.Type()}}
+	if want.Kind() == reflect.Slice {
+		c.length = want.Len()
+	}
+	if len(d.cfg.ignoredFields) > 0 {
+		c.fields = path.fields
+	}
+	e := entered{comparison: c, reported: len(d.diffs), cycles: d.cycles}
+	if d.visited[c.visit] {
+		// Like in reflect.DeepEqual, the pair is assumed to be equal
+		d.cycles++
+		return e, false
+	}
+	if equal, found := d.compared[c]; found {
+		if !equal {
+			d.reportUndescribed(path)
+		}
+		return e, false
+	}
+	d.visited[c.visit] = true
+	return e, true
+}
+
+// leave unmarks the pair of references once walked, and records whether it's equal, i.e. whether
+// no differences were reported since it was entered. It's only recorded as equal if no cycles
+// were found meanwhile, as it's otherwise only equal if the pairs assumed to be equal further up
+// the path turn out to be.
+func (d *differ) leave(e entered) {
+	delete(d.visited, e.visit)
+	switch {
+	case len(d.diffs) > e.reported:
+		d.compared[e.comparison] = false
+	case d.cycles == e.cycles:
+		d.compared[e.comparison] = true
+	}
+}
+
+func (d *differ) walk(path valuePath, want, got reflect.Value) {
+	if d.done() || d.cfg.ignores(path) {
+		return
+	}
+
+	if d.partial && (!want.IsValid() || want.IsZero()) {
+		return
+	}
+
+	if !want.IsValid() || !got.IsValid() {
+		if want.IsValid() != got.IsValid() {
+			d.report(path, want, got)
+		}
+		return
+	}
+
+	if want.Type() != got.Type() {
+		d.reportTypes(path, want, got)
+		return
+	}
+
+	if equal, ok := d.cfg.compare(want, got); ok {
+		if !equal {
+			d.report(path, want, got)
+		}
+		return
+	}
+
+	switch want.Kind() {
+	case reflect.Array:
+		for i := 0; i < want.Len(); i++ {
+			d.walk(path.index(i), want.Index(i), got.Index(i))
+		}
+	case reflect.Slice:
+		if d.cfg.nilEqualsEmpty && want.Len() == 0 && got.Len() == 0 {
+			return
+		}
+		if want.IsNil() != got.IsNil() {
+			d.report(path, want, got)
+			return
+		}
+		if want.Pointer() == got.Pointer() && want.Len() == got.Len() {
+			return
+		}
+		e, ok := d.enter(path, want, got)
+		if !ok {
+			return
+		}
+		defer d.leave(e)
+		d.walkSequence(path, want, got)
+	case reflect.Map:
+		if d.cfg.nilEqualsEmpty && want.Len() == 0 && got.Len() == 0 {
+			return
+		}
+		if want.IsNil() != got.IsNil() {
+			d.report(path, want, got)
+			return
+		}
+		if want.Pointer() == got.Pointer() {
+			return
+		}
+		e, ok := d.enter(path, want, got)
+		if !ok {
+			return
+		}
+		defer d.leave(e)
+		d.walkMap(path, want, got)
+	case reflect.Ptr:
+		if want.Pointer() == got.Pointer() {
+			return
+		}
+		if want.IsNil() || got.IsNil() {
+			d.report(path, want, got)
+			return
+		}
+		e, ok := d.enter(path, want, got)
+		if !ok {
+			return
+		}
+		defer d.leave(e)
+		d.walk(path, want.Elem(), got.Elem())
+	case reflect.Interface:
+		if want.IsNil() || got.IsNil() {
+			if want.IsNil() != got.IsNil() {
+				d.report(path, want, got)
+			}
+			return
+		}
+		d.walk(path, want.Elem(), got.Elem())
+	case reflect.Struct:
+		for i := 0; i < want.NumField(); i++ {
+			d.walk(path.field(want.Type().Field(i).Name), want.Field(i), got.Field(i))
+		}
+	case reflect.Func:
+		// Like reflect.DeepEqual, functions are only equal if both are nil
+		if !want.IsNil() || !got.IsNil() {
+			d.report(path, want, got)
+		}
+	default:
+		if !basicEqual(want, got, d.cfg) {
+			d.report(path, want, got)
+		}
+	}
+}
+
+func (d *differ) walkSequence(path valuePath, want, got reflect.Value) {
+	n := want.Len()
+	if got.Len() > n {
+		n = got.Len()
+	}
+	for i := 0; i < n; i++ {
+		elemPath := path.index(i)
+		switch {
+		case i >= got.Len():
+			d.reportMissing(elemPath, want.Index(i), reflect.Value{})
+		case i >= want.Len():
+			d.reportMissing(elemPath, reflect.Value{}, got.Index(i))
+		default:
+			d.walk(elemPath, want.Index(i), got.Index(i))
+		}
+	}
+}
+
+func (d *differ) walkMap(path valuePath, want, got reflect.Value) {
+	for _, key := range sortedKeys(want) {
+		keyPath := path.key(key)
+		wantElem, gotElem := want.MapIndex(key), got.MapIndex(key)
+		if !gotElem.IsValid() {
+			// In partial mode, zero values match any value, including a missing one
+			if !d.cfg.ignores(keyPath) && !(d.partial && wantElem.IsZero()) {
+				d.reportMissing(keyPath, wantElem, reflect.Value{})
+			}
+			continue
+		}
+		d.walk(keyPath, wantElem, gotElem)
+	}
+	if d.partial {
+		return
+	}
+	for _, key := range sortedKeys(got) {
+		if !want.MapIndex(key).IsValid() && !d.cfg.ignores(path.key(key)) {
+			d.reportMissing(path.key(key), reflect.Value{}, got.MapIndex(key))
+		}
+	}
+}
+
+// sortedKeys returns the keys of the map value, sorted by their formatted representation so
+// differences are reported in a deterministic order.
+func sortedKeys(m reflect.Value) []reflect.Value {
+	keys := m.MapKeys()
+	sort.Slice(keys, func(i, j int) bool {
+		return formatValue(keys[i]) < formatValue(keys[j])
+	})
+	return keys
+}
+
+// basicEqual compares values of the same non-composite type. The kind specific accessors are
+// used, since values reached through unexported struct fields can't be converted to interfaces.
+func basicEqual(want, got reflect.Value, cfg equalConfig) bool {
+	switch want.Kind() {
+	case reflect.Bool:
+		return want.Bool() == got.Bool()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return want.Int() == got.Int()
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return want.Uint() == got.Uint()
+	case reflect.Float32, reflect.Float64:
+		return cfg.floatsEqual(complex(want.Float(), 0), complex(got.Float(), 0))
+	case reflect.Complex64, reflect.Complex128:
+		return cfg.floatsEqual(want.Complex(), got.Complex())
+	case reflect.String:
+		return want.String() == got.String()
+	case reflect.Chan, reflect.UnsafePointer:
+		return want.Pointer() == got.Pointer()
+	default:
+		return false
+	}
+}
+
+// formatValue returns a short human readable representation of the value.
+func formatValue(v reflect.Value) string {
+	if !v.IsValid() {
+		return "nil"
+	}
+	switch v.Kind() {
+	case reflect.String:
+		return strconv.Quote(v.String())
+	case reflect.Func:
+		if v.IsNil() {
+			return "nil"
+		}
+		return "function"
+	case reflect.Chan:
+		return "chan"
+	case reflect.Ptr, reflect.Map, reflect.Slice, reflect.Interface:
+		if v.IsNil() {
+			return "nil"
+		}
+	}
+	return fmt.Sprintf("%v", v)
+}
+
+// formatDifferences returns the differences as failure message details.
+func formatDifferences(diffs []difference) []string {
+	details := make([]string, 0, len(diffs))
+	for i, d := range diffs {
+		if i == maxReportedDifferences {
+			details = append(details, fmt.Sprintf("... and %d more differences", len(diffs)-i))
+			break
+		}
+		details = append(details, d.String())
+	}
+	return details
+}
@@ -0,0 +1,267 @@
+package assert
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestDiff(t *testing.T) {
+	type item struct {
+		Name  string
+		Price int
+	}
+	type order struct {
+		ID    int
+		Items []item
+		Tags  map[string]string
+		Note  *string
+	}
+	note := "note"
+
+	type args struct {
+		want interface{}
+		got  interface{}
+	}
+	tests := []struct {
+		name string
+		args args
+		want []string
+	}{
+		{
+			name: "should return nothing when both nil",
+			args: args{want: nil, got: nil},
+			want: nil,
+		},
+		{
+			name: "should return nothing when deeply equal",
+			args: args{
+				want: order{ID: 1, Items: []item{{"a", 1}}, Note: &note},
+				got:  order{ID: 1, Items: []item{{"a", 1}}, Note: &note},
+			},
+			want: nil,
+		},
+		{
+			name: "should return root difference when scalars differ",
+			args: args{want: 10, got: 12},
+			want: []string{"(root): want 10, got 12"},
+		},
+		{
+			name: "should return root difference with types when types differ",
+			args: args{want: 10, got: "10"},
+			want: []string{`(root): want 10 (int), got "10" (string)`},
+		},
+		{
+			name: "should return nested paths when struct fields differ",
+			args: args{
+				want: order{ID: 1, Items: []item{{"a", 1}, {"b", 10}}},
+				got:  order{ID: 2, Items: []item{{"a", 1}, {"b", 12}}},
+			},
+			want: []string{
+				".ID: want 1, got 2",
+				".Items[1].Price: want 10, got 12",
+			},
+		},
+		{
+			name: "should return missing and unexpected slice elements",
+			args: args{
+				want: []int{1, 2},
+				got:  []int{1, 3, 4},
+			},
+			want: []string{
+				"[1]: want 2, got 3",
+				"[2]: unexpected, got 4",
+			},
+		},
+		{
+			name: "should return missing and unexpected map keys",
+			args: args{
+				want: order{Tags: map[string]string{"a": "1", "b": "2"}},
+				got:  order{Tags: map[string]string{"a": "x", "c": "3"}},
+			},
+			want: []string{
+				`.Tags["a"]: want "1", got "x"`,
+				`.Tags["b"]: missing, want "2"`,
+				`.Tags["c"]: unexpected, got "3"`,
+			},
+		},
+		{
+			name: "should return difference when want nil slice but get empty slice",
+			args: args{
+				want: order{},
+				got:  order{Items: []item{}},
+			},
+			want: []string{".Items: want nil, got []"},
+		},
+		{
+			name: "should return difference when pointer targets differ",
+			args: args{
+				want: &item{Name: "a"},
+				got:  &item{Name: "b"},
+			},
+			want: []string{`.Name: want "a", got "b"`},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Given
+			assert := New(t)
+
+			// When
+			diffs := diff(tt.args.want, tt.args.got, equalConfig{})
+
+			// Then
+			var got []string
+			for _, d := range diffs {
+				got = append(got, d.String())
+			}
+			assert(got).Equals(tt.want)
+		})
+	}
+}
+
+func TestDifferenceString(t *testing.T) {
+	tests := []struct {
+		name string
+		diff difference
+		want string
+	}{
+		{
+			name: "should show both values",
+			diff: difference{path: ".A", want: "1", got: "2"},
+			want: ".A: want 1, got 2",
+		},
+		{
+			name: "should show empty values as values",
+			diff: difference{path: ".A", want: "", got: "x"},
+			want: ".A: want , got x",
+		},
+		{
+			name: "should show missing path",
+			diff: difference{path: ".A", want: "1", missing: true},
+			want: ".A: missing, want 1",
+		},
+		{
+			name: "should show unexpected path",
+			diff: difference{path: ".A", got: "2", unexpected: true},
+			want: ".A: unexpected, got 2",
+		},
+		{
+			name: "should show root",
+			diff: difference{want: "1", got: "2"},
+			want: "(root): want 1, got 2",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Given
+			assert := New(t)
+
+			// When
+			got := tt.diff.String()
+
+			// Then
+			assert(got).Equals(tt.want)
+		})
+	}
+}
+
+func TestDiffHandlesCycles(t *testing.T) {
+	// Given
+	assert := New(t)
+	type node struct {
+		Value int
+		Next  *node
+	}
+	want := &node{Value: 1}
+	want.Next = want
+	got := &node{Value: 1}
+	got.Next = got
+
+	// When
+	diffs := diff(want, got, equalConfig{})
+
+	// Then
+	assert(diffs).IsEmpty()
+}
+
+func TestDiffComparesSharedReferencesAtEveryPath(t *testing.T) {
+	// Given
+	assert := New(t)
+	type inner struct {
+		X int
+	}
+	type outer struct {
+		A *inner
+		B *inner
+	}
+	shared := &inner{X: 1}
+	want := &inner{X: 2}
+	cfg := equalConfig{}
+	IgnoreFields("A.X")(&cfg)
+
+	// When
+	diffs := diff(outer{A: want, B: want}, outer{A: shared, B: shared}, cfg)
+
+	// Then
+	assert(diffs).Equals([]difference{{path: ".B.X", want: "2", got: "1"}})
+}
+
+func TestDiffComparesSharedReferencesOnce(t *testing.T) {
+	// Given
+	assert := New(t)
+	type node struct {
+		Value       int
+		Left, Right *node
+	}
+	newGraph := func(depth int) *node {
+		n := &node{}
+		for i := 0; i < depth; i++ {
+			n = &node{Value: i, Left: n, Right: n}
+		}
+		return n
+	}
+	want, got := newGraph(64), newGraph(64)
+
+	// When
+	start := time.Now()
+	diffs := diff(want, got, equalConfig{})
+	equal := equalsWith(got, want, equalConfig{})
+	elapsed := time.Since(start)
+
+	// Then
+	assert(diffs).IsEmpty()
+	assert(equal).IsTrue()
+	assert(elapsed < time.Second).IsTrue()
+}
+
+func TestEqualsReportsDifferences(t *testing.T) {
+	// Given
+	assert := New(t)
+	type item struct {
+		Price int
+	}
+	dummyT := &fakeT{}
+	dummyAssert := New(dummyT)
+
+	// When
+	dummyAssert([]item{{1}, {12}}).Equals([]item{{1}, {10}})
+
+	// Then
+	assert(len(dummyT.logs)).Equals(1)
+	assert(strings.Contains(dummyT.logs[0], "[1].Price: want 10, got 12")).IsTrue()
+}
+
+func TestEqualsReportsTypesOfRootValues(t *testing.T) {
+	// Given
+	assert := New(t)
+	dummyT := &fakeT{}
+	dummyAssert := New(dummyT)
+
+	// When
+	dummyAssert(int64(3)).Equals(3)
+
+	// Then
+	assert(len(dummyT.logs)).Equals(1)
+	assert(strings.Contains(dummyT.logs[0], "(root): want 3 (int), got 3 (int64)")).IsTrue()
+}
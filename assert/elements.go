@@ -0,0 +1,216 @@
+package assert
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// elementT is the TestingT of the asserters passed to per-element callbacks. It records whether
+// any assertion failed, and either forwards failures to the parent TestingT or keeps their
+// descriptions, including the expected and observed values.
+type elementT struct {
+	parent       TestingT
+	forward      bool
+	failed       bool
+	descriptions []string
+}
+
+func (e *elementT) Fail() {
+	e.failed = true
+	if e.forward {
+		e.parent.Fail()
+	}
+}
+
+func (e *elementT) Fatal(args ...interface{}) {
+	e.failed = true
+	if e.forward {
+		e.parent.Fatal(args...)
+	}
+}
+
+func (e *elementT) Log(args ...interface{}) {
+	if e.forward {
+		e.parent.Log(args...)
+	}
+}
+
+func (e *elementT) record(description string) {
+	e.failed = true
+	e.descriptions = append(e.descriptions, description)
+}
+
+// recorderOf returns the elementT keeping failures reported to t, if any. Failures are kept by
+// the first elementT in the chain of parents that doesn't forward them. The forwarding ones passed
+// on the way are marked as failed.
+func recorderOf(t TestingT) *elementT {
+	var forwarding []*elementT
+	for {
+		e, ok := t.(*elementT)
+		if !ok {
+			return nil
+		}
+		if !e.forward {
+			for _, f := range forwarding {
+				f.failed = true
+			}
+			return e
+		}
+		forwarding = append(forwarding, e)
+		t = e.parent
+	}
+}
+
+// element is a single element of a collection, labeled by its index or key.
+type element struct {
+	label string
+	value interface{}
+}
+
+// elements returns the elements of a slice or array, or the values of a map ordered by key.
+func elements(collection interface{}) ([]element, error) {
+	if collection == nil {
+		return nil, fmt.Errorf("nil isn't a collection")
+	}
+	value := reflect.ValueOf(collection)
+	switch value.Kind() {
+	case reflect.Array, reflect.Slice:
+		elems := make([]element, 0, value.Len())
+		for i := 0; i < value.Len(); i++ {
+			elems = append(elems, element{label: fmt.Sprintf("[%d]", i), value: value.Index(i).Interface()})
+		}
+		return elems, nil
+	case reflect.Map:
+		elems := make([]element, 0, value.Len())
+		for _, key := range sortedKeys(value) {
+			elems = append(elems, element{label: fmt.Sprintf("[%s]", formatValue(key)), value: value.MapIndex(key).Interface()})
+		}
+		return elems, nil
+	default:
+		return nil, fmt.Errorf("values of type %T aren't collections, only slices, arrays and maps are", collection)
+	}
+}
+
+// elementResult is the outcome of calling a per-element callback.
+type elementResult struct {
+	element
+	passed       bool
+	descriptions []string
+}
+
+// checkElements calls fn with an asserter for every element of the observed value. If forward is
+// true, failures are reported to the asserter's TestingT. Otherwise they're only recorded.
+func (a asserter) checkElements(fn func(elem Asserter), forward bool) ([]elementResult, error) {
+	elems, err := elements(a.got)
+	if err != nil {
+		return nil, err
+	}
+	results := make([]elementResult, 0, len(elems))
+	for _, elem := range elems {
+		recorder := &elementT{parent: a.t, forward: forward}
+		fn(asserter{got: elem.value, t: recorder, fatal: a.fatal && forward, path: a.path + elem.label, jsonCfg: a.jsonCfg})
+		results = append(results, elementResult{element: elem, passed: !recorder.failed, descriptions: recorder.descriptions})
+	}
+	return results, nil
+}
+
+// summarize returns the labels of the elements that passed, and a description of the elements
+// that failed.
+func summarize(results []elementResult) (passed []string, failed []string) {
+	for _, result := range results {
+		if result.passed {
+			passed = append(passed, result.label)
+			continue
+		}
+		failed = append(failed, strings.Join(result.descriptions, "\n"))
+	}
+	return passed, failed
+}
+
+// Each asserts every element of the observed value satisfies the assertions made by 'fn'. The
+// observed value must be a slice, array or map, and fn is called with an asserter for every
+// element, or map value in key order. Failures are labeled with the element's index or key. If
+// any element fails, the function under test is marked as having failed.
+//
+//	Example:
+//		assert(statuses).Each(func(status assert.Asserter) {
+//			status.Equals("OK")
+//		})
+func (a asserter) Each(fn func(elem Asserter)) bool {
+	if a.navigationFailed {
+		return false
+	}
+	results, err := a.checkElements(fn, true)
+	if err != nil {
+		a.errorf(fmt.Sprintf("Invalid argument: %v", err), nil, false)
+		return false
+	}
+	for _, result := range results {
+		if !result.passed {
+			return false
+		}
+	}
+	return true
+}
+
+// AnyElement asserts at least one element of the observed value satisfies the assertions made by
+// 'fn'. See the Each method for how fn is called. Failed assertions for individual elements aren't
+// reported unless no element satisfies them, in which case the function under test is marked as
+// having failed.
+func (a asserter) AnyElement(fn func(elem Asserter)) bool {
+	if a.navigationFailed {
+		return false
+	}
+	results, err := a.checkElements(fn, false)
+	if err != nil {
+		a.errorf(fmt.Sprintf("Invalid argument: %v", err), nil, false)
+		return false
+	}
+	passed, failed := summarize(results)
+	if len(passed) == 0 {
+		a.errorf("At least one observed element must satisfy the assertions", nil, false, failed...)
+		return false
+	}
+	return true
+}
+
+// NoElement asserts no element of the observed value satisfies the assertions made by 'fn'. See
+// the Each method for how fn is called. If any element satisfies them, the function under test is
+// marked as having failed.
+func (a asserter) NoElement(fn func(elem Asserter)) bool {
+	if a.navigationFailed {
+		return false
+	}
+	results, err := a.checkElements(fn, false)
+	if err != nil {
+		a.errorf(fmt.Sprintf("Invalid argument: %v", err), nil, false)
+		return false
+	}
+	if passed, _ := summarize(results); len(passed) > 0 {
+		a.errorf("No observed element may satisfy the assertions", nil, false,
+			fmt.Sprintf("Satisfied by: %s", strings.Join(passed, ", ")))
+		return false
+	}
+	return true
+}
+
+// ExactlyN asserts exactly 'n' elements of the observed value satisfy the assertions made by 'fn'.
+// See the Each method for how fn is called. If another number of elements satisfy them, the
+// function under test is marked as having failed.
+func (a asserter) ExactlyN(n int, fn func(elem Asserter)) bool {
+	if a.navigationFailed {
+		return false
+	}
+	results, err := a.checkElements(fn, false)
+	if err != nil {
+		a.errorf(fmt.Sprintf("Invalid argument: %v", err), n, true)
+		return false
+	}
+	if passed, _ := summarize(results); len(passed) != n {
+		a.errorf(fmt.Sprintf("Exactly %d observed elements must satisfy the assertions", n), n, true,
+			fmt.Sprintf("Satisfied by %d: %s", len(passed), strings.Join(passed, ", ")))
+		return false
+	}
+	return true
+}
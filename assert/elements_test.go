@@ -0,0 +1,275 @@
+package assert
+
+import (
+	"strings"
+	"testing"
+)
+
+func elementsTestIsPositive(elem Asserter) { elem.GreaterThan(0) }
+
+func elementsTestRowIsPositive(row Asserter) { row.Each(elementsTestIsPositive) }
+
+func TestEach(t *testing.T) {
+	type args struct {
+		got interface{}
+		fn  func(elem Asserter)
+	}
+	tests := []struct {
+		name           string
+		args           args
+		want           bool
+		wantTestFailed bool
+		wantMessage    []string
+	}{
+		{
+			name:           "should pass when each element satisfies assertions",
+			args:           args{got: []int{1, 2, 3}, fn: elementsTestIsPositive},
+			want:           true,
+			wantTestFailed: false,
+		},
+		{
+			name:           "should fail when an element doesn't satisfy assertions",
+			args:           args{got: []int{1, -2, 3}, fn: elementsTestIsPositive},
+			want:           false,
+			wantTestFailed: true,
+			wantMessage:    []string{"Description: [1]: Observed value must be greater than expected"},
+		},
+		{
+			name:           "should pass when each map value satisfies assertions",
+			args:           args{got: map[string]int{"a": 1, "b": 2}, fn: elementsTestIsPositive},
+			want:           true,
+			wantTestFailed: false,
+		},
+		{
+			name:           "should pass when slice is empty",
+			args:           args{got: []int{}, fn: elementsTestIsPositive},
+			want:           true,
+			wantTestFailed: false,
+		},
+		{
+			name:           "should fail when get non-collection",
+			args:           args{got: nonZero["int"], fn: elementsTestIsPositive},
+			want:           false,
+			wantTestFailed: true,
+			wantMessage:    []string{"Invalid argument: values of type int aren't collections"},
+		},
+		{
+			name:           "should fail when nested element doesn't satisfy assertions",
+			args:           args{got: [][]int{{1}, {2, -3}}, fn: elementsTestRowIsPositive},
+			want:           false,
+			wantTestFailed: true,
+			wantMessage:    []string{"Description: [1][1]: Observed value must be greater than expected"},
+		},
+		{
+			name:           "should label failures with index and key",
+			args:           args{got: map[string][]int{"a": {1}, "b": {1, -2}}, fn: elementsTestRowIsPositive},
+			want:           false,
+			wantTestFailed: true,
+			wantMessage:    []string{`Description: ["b"][1]: Observed value must be greater than expected`},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Given
+			assert := New(t)
+			dummyT := &fakeT{}
+			dummyAssert := New(dummyT)
+
+			// When
+			got := dummyAssert(tt.args.got).Each(tt.args.fn)
+
+			// Then
+			assert(got).Equals(tt.want)
+			assert(dummyT.failed).Equals(tt.wantTestFailed)
+			assert(len(dummyT.logs) <= 1).IsTrue()
+			for _, want := range tt.wantMessage {
+				assert(strings.Contains(strings.Join(dummyT.logs, "\n"), want)).IsTrue()
+			}
+		})
+	}
+}
+
+func TestAnyElement(t *testing.T) {
+	type item struct{ Status, Name string }
+
+	type args struct {
+		got interface{}
+		fn  func(elem Asserter)
+	}
+	tests := []struct {
+		name           string
+		args           args
+		want           bool
+		wantTestFailed bool
+		wantMessage    []string
+	}{
+		{
+			name:           "should pass when any element satisfies assertions",
+			args:           args{got: []int{-1, 2, -3}, fn: elementsTestIsPositive},
+			want:           true,
+			wantTestFailed: false,
+		},
+		{
+			name:           "should fail when no element satisfies assertions",
+			args:           args{got: []int{-1, -2}, fn: elementsTestIsPositive},
+			want:           false,
+			wantTestFailed: true,
+			wantMessage:    []string{"At least one observed element must satisfy the assertions"},
+		},
+		{
+			name:           "should fail when slice is empty",
+			args:           args{got: []int{}, fn: elementsTestIsPositive},
+			want:           false,
+			wantTestFailed: true,
+			wantMessage:    []string{"At least one observed element must satisfy the assertions"},
+		},
+		{
+			name:           "should pass when any element satisfies nested assertions",
+			args:           args{got: [][]int{{-1, 2}, {3, 4}}, fn: elementsTestRowIsPositive},
+			want:           true,
+			wantTestFailed: false,
+		},
+		{
+			name:           "should fail when no element satisfies nested assertions",
+			args:           args{got: [][]int{{-1, 2}, {3, -4}}, fn: elementsTestRowIsPositive},
+			want:           false,
+			wantTestFailed: true,
+			wantMessage: []string{
+				"[0][0]: Observed value must be greater than expected",
+				"[1][1]: Observed value must be greater than expected",
+			},
+		},
+		{
+			name:           "should report failures of every element",
+			args:           args{got: []string{"a", "b"}, fn: func(elem Asserter) { elem.Equals("c") }},
+			want:           false,
+			wantTestFailed: true,
+			wantMessage: []string{
+				"[0]: Observed and expected values must be equal\n\t\tExpected: c\n\t\tObserved: a",
+				"[1]: Observed and expected values must be equal\n\t\tExpected: c\n\t\tObserved: b",
+			},
+		},
+		{
+			name: "should report details of every element",
+			args: args{
+				got: []item{{Status: "FAILED", Name: "a"}},
+				fn:  func(elem Asserter) { elem.Equals(item{Status: "OK", Name: "a"}) },
+			},
+			want:           false,
+			wantTestFailed: true,
+			wantMessage:    []string{"\n\t\t.Status: want \"OK\", got \"FAILED\""},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Given
+			assert := New(t)
+			dummyT := &fakeT{}
+			dummyAssert := New(dummyT)
+
+			// When
+			got := dummyAssert(tt.args.got).AnyElement(tt.args.fn)
+
+			// Then
+			assert(got).Equals(tt.want)
+			assert(dummyT.failed).Equals(tt.wantTestFailed)
+			assert(len(dummyT.logs) <= 1).IsTrue()
+			for _, want := range tt.wantMessage {
+				assert(strings.Contains(strings.Join(dummyT.logs, "\n"), want)).IsTrue()
+			}
+		})
+	}
+}
+
+func TestNoElement(t *testing.T) {
+	type args struct {
+		got interface{}
+		fn  func(elem Asserter)
+	}
+	tests := []struct {
+		name           string
+		args           args
+		want           bool
+		wantTestFailed bool
+		wantMessage    []string
+	}{
+		{
+			name:           "should pass when no element satisfies assertions",
+			args:           args{got: []int{-1, -2}, fn: elementsTestIsPositive},
+			want:           true,
+			wantTestFailed: false,
+		},
+		{
+			name:           "should fail when an element satisfies assertions",
+			args:           args{got: []int{-1, 2}, fn: elementsTestIsPositive},
+			want:           false,
+			wantTestFailed: true,
+			wantMessage:    []string{"No observed element may satisfy the assertions", "Satisfied by: [1]"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Given
+			assert := New(t)
+			dummyT := &fakeT{}
+			dummyAssert := New(dummyT)
+
+			// When
+			got := dummyAssert(tt.args.got).NoElement(tt.args.fn)
+
+			// Then
+			assert(got).Equals(tt.want)
+			assert(dummyT.failed).Equals(tt.wantTestFailed)
+			for _, want := range tt.wantMessage {
+				assert(strings.Contains(strings.Join(dummyT.logs, "\n"), want)).IsTrue()
+			}
+		})
+	}
+}
+
+func TestExactlyN(t *testing.T) {
+	type args struct {
+		got interface{}
+		n   int
+		fn  func(elem Asserter)
+	}
+	tests := []struct {
+		name           string
+		args           args
+		want           bool
+		wantTestFailed bool
+		wantMessage    []string
+	}{
+		{
+			name:           "should pass when exactly n elements satisfy assertions",
+			args:           args{got: []int{-1, 2, 3}, n: 2, fn: elementsTestIsPositive},
+			want:           true,
+			wantTestFailed: false,
+		},
+		{
+			name:           "should fail when other number of elements satisfy assertions",
+			args:           args{got: []int{-1, 2, 3}, n: 1, fn: elementsTestIsPositive},
+			want:           false,
+			wantTestFailed: true,
+			wantMessage:    []string{"Exactly 1 observed elements must satisfy the assertions", "Satisfied by 2: [1], [2]"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Given
+			assert := New(t)
+			dummyT := &fakeT{}
+			dummyAssert := New(dummyT)
+
+			// When
+			got := dummyAssert(tt.args.got).ExactlyN(tt.args.n, tt.args.fn)
+
+			// Then
+			assert(got).Equals(tt.want)
+			assert(dummyT.failed).Equals(tt.wantTestFailed)
+			for _, want := range tt.wantMessage {
+				assert(strings.Contains(strings.Join(dummyT.logs, "\n"), want)).IsTrue()
+			}
+		})
+	}
+}
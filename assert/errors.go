@@ -0,0 +1,205 @@
+package assert
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"regexp"
+	"strings"
+)
+
+var errorType = reflect.TypeOf((*error)(nil)).Elem()
+
+// IsErrorMatching asserts the observed value is an error that matches the 'target' error
+// according to errors.Is, meaning that target is found somewhere in the observed error's chain.
+// If it's not, the function under test is marked as having failed.
+//
+//	Example:
+//		assert(err).IsErrorMatching(io.EOF)
+func (a asserter) IsErrorMatching(target error) bool {
+	if a.navigationFailed {
+		return false
+	}
+	gotErr, ok := a.observedError(false, target)
+	if !ok {
+		return false
+	}
+	if !errors.Is(gotErr, target) {
+		a.errorf("Observed error must match expected error", target, true, errorChainDetails(gotErr))
+		return false
+	}
+	return true
+}
+
+// IsErrorOfType asserts the observed value is an error with an error of the target's type in its
+// chain, according to errors.As. The target must be a non-nil pointer to a type implementing
+// error, or to an interface type. On success, the target is set to the matching error, just like
+// errors.As does. If not, the function under test is marked as having failed.
+//
+//	Example:
+//		var pathErr *fs.PathError
+//		assert(err).IsErrorOfType(&pathErr)
+func (a asserter) IsErrorOfType(target interface{}) bool {
+	if a.navigationFailed {
+		return false
+	}
+	if err := validateErrorsAsTarget(target); err != nil {
+		a.errorf(fmt.Sprintf("Invalid argument: %v", err), target, true)
+		return false
+	}
+	gotErr, ok := a.observedError(true, target)
+	if !ok {
+		return false
+	}
+	if !errors.As(gotErr, target) {
+		wantType := reflect.TypeOf(target).Elem()
+		a.errorf(fmt.Sprintf("Observed error chain must contain an error of type %s", wantType), target, true,
+			errorChainDetails(gotErr))
+		return false
+	}
+	return true
+}
+
+func validateErrorsAsTarget(target interface{}) error {
+	if target == nil {
+		return errors.New("target must be a non-nil pointer")
+	}
+	typ := reflect.TypeOf(target)
+	if typ.Kind() != reflect.Ptr || reflect.ValueOf(target).IsNil() {
+		return errors.New("target must be a non-nil pointer")
+	}
+	if elem := typ.Elem(); elem.Kind() != reflect.Interface && !elem.Implements(errorType) {
+		return errors.New("target must be a pointer to an interface or to a type implementing error")
+	}
+	return nil
+}
+
+// HasErrorMessage asserts the observed value is an error whose message equals the 'want'
+// argument. If not, the function under test is marked as having failed.
+func (a asserter) HasErrorMessage(want string) bool {
+	if a.navigationFailed {
+		return false
+	}
+	gotErr, ok := a.observedError(true, want)
+	if !ok {
+		return false
+	}
+	if gotErr.Error() != want {
+		a.errorf("Observed error message must equal expected message", want, true, errorChainDetails(gotErr))
+		return false
+	}
+	return true
+}
+
+// ErrorMessageContains asserts the observed value is an error whose message contains the 'want'
+// argument. If not, the function under test is marked as having failed.
+func (a asserter) ErrorMessageContains(want string) bool {
+	if a.navigationFailed {
+		return false
+	}
+	gotErr, ok := a.observedError(true, want)
+	if !ok {
+		return false
+	}
+	if !strings.Contains(gotErr.Error(), want) {
+		a.errorf("Observed error message must contain expected substring", want, true, errorChainDetails(gotErr))
+		return false
+	}
+	return true
+}
+
+// ErrorMessageMatches asserts the observed value is an error whose message matches the regular
+// expression 'pattern', which must be either a string or a *regexp.Regexp. If not, the function
+// under test is marked as having failed.
+//
+//	Example:
+//		assert(err).ErrorMessageMatches(`^open .*: no such file or directory$`)
+func (a asserter) ErrorMessageMatches(pattern interface{}) bool {
+	if a.navigationFailed {
+		return false
+	}
+	re, err := toRegexp(pattern)
+	if err != nil {
+		a.errorf(fmt.Sprintf("Invalid argument: %v", err), pattern, true)
+		return false
+	}
+	gotErr, ok := a.observedError(true, re)
+	if !ok {
+		return false
+	}
+	if !re.MatchString(gotErr.Error()) {
+		a.errorf("Observed error message must match expected pattern", re, true, errorChainDetails(gotErr))
+		return false
+	}
+	return true
+}
+
+// toRegexp returns the pattern as a compiled regular expression. The pattern must be either a
+// string or a *regexp.Regexp.
+func toRegexp(pattern interface{}) (*regexp.Regexp, error) {
+	switch p := pattern.(type) {
+	case *regexp.Regexp:
+		if p == nil {
+			return nil, errors.New("pattern must be non-nil")
+		}
+		return p, nil
+	case string:
+		return regexp.Compile(p)
+	default:
+		return nil, fmt.Errorf("pattern must be a string or *regexp.Regexp, got %T", pattern)
+	}
+}
+
+// observedError returns the observed value as an error. If the observed value isn't an error, is
+// an error interface holding a nil pointer (typed nil), or is nil although 'nonNil' is set, the
+// function under test is marked as having failed and the second return value is false.
+func (a asserter) observedError(nonNil bool, want interface{}) (error, bool) {
+	if a.got == nil {
+		if nonNil {
+			a.errorf("Observed value must be a non-nil error", want, true)
+			return nil, false
+		}
+		return nil, true
+	}
+	err, ok := a.got.(error)
+	if !ok {
+		a.errorf("Observed value must be an error", want, true)
+		return nil, false
+	}
+	if isTypedNil(err) {
+		a.errorf("Observed error must not be a non-nil interface holding a nil pointer (typed nil)", want, true)
+		return nil, false
+	}
+	return err, true
+}
+
+// errorChainDetails returns the error's chain of wrapped errors as a failure message detail, one
+// error per line. Errors wrapping multiple errors have their branches indented.
+func errorChainDetails(err error) string {
+	var b strings.Builder
+	b.WriteString("Error chain:")
+	writeErrorChain(&b, err, 1)
+	return b.String()
+}
+
+func writeErrorChain(b *strings.Builder, err error, depth int) {
+	for err != nil {
+		if isTypedNil(err) {
+			// Calling Error on a nil pointer would usually panic
+			fmt.Fprintf(b, "\n%s%T: <nil>", strings.Repeat("  ", depth), err)
+			return
+		}
+		fmt.Fprintf(b, "\n%s%T: %s", strings.Repeat("  ", depth), err, err.Error())
+		switch wrapper := err.(type) {
+		case interface{ Unwrap() error }:
+			err = wrapper.Unwrap()
+		case interface{ Unwrap() []error }:
+			for _, wrapped := range wrapper.Unwrap() {
+				writeErrorChain(b, wrapped, depth+1)
+			}
+			return
+		default:
+			return
+		}
+	}
+}
@@ -0,0 +1,417 @@
+package assert
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"regexp"
+	"strings"
+	"testing"
+)
+
+type dummyError struct {
+	code int
+}
+
+func (e *dummyError) Error() string {
+	return fmt.Sprintf("dummy error %d", e.code)
+}
+
+func TestIsErrorMatching(t *testing.T) {
+	var typedNil error = (*dummyError)(nil)
+
+	type args struct {
+		got    interface{}
+		target error
+	}
+	tests := []struct {
+		name           string
+		args           args
+		want           bool
+		wantTestFailed bool
+		wantMessage    []string
+	}{
+		{
+			name:           "should pass when get target",
+			args:           args{got: io.EOF, target: io.EOF},
+			want:           true,
+			wantTestFailed: false,
+		},
+		{
+			name:           "should pass when get error wrapping target",
+			args:           args{got: fmt.Errorf("reading: %w", io.EOF), target: io.EOF},
+			want:           true,
+			wantTestFailed: false,
+		},
+		{
+			name:           "should fail when get error not wrapping target",
+			args:           args{got: fmt.Errorf("reading: %v", io.EOF), target: io.EOF},
+			want:           false,
+			wantTestFailed: true,
+			wantMessage:    []string{"Observed error must match expected error", "*errors.errorString: reading: EOF"},
+		},
+		{
+			name:           "should fail when get nil",
+			args:           args{got: nil, target: io.EOF},
+			want:           false,
+			wantTestFailed: true,
+			wantMessage:    []string{"Observed error must match expected error"},
+		},
+		{
+			name:           "should fail when get non-error",
+			args:           args{got: nonZero["string"], target: io.EOF},
+			want:           false,
+			wantTestFailed: true,
+			wantMessage:    []string{"Observed value must be an error"},
+		},
+		{
+			name:           "should fail when get typed nil",
+			args:           args{got: typedNil, target: io.EOF},
+			want:           false,
+			wantTestFailed: true,
+			wantMessage:    []string{"Observed error must not be a non-nil interface holding a nil pointer (typed nil)"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Given
+			assert := New(t)
+			dummyT := &fakeT{}
+			dummyAssert := New(dummyT)
+
+			// When
+			got := dummyAssert(tt.args.got).IsErrorMatching(tt.args.target)
+
+			// Then
+			assert(got).Equals(tt.want)
+			assert(dummyT.failed).Equals(tt.wantTestFailed)
+			for _, want := range tt.wantMessage {
+				assert(strings.Contains(strings.Join(dummyT.logs, "\n"), want)).IsTrue()
+			}
+		})
+	}
+}
+
+func TestIsErrorOfType(t *testing.T) {
+	var dummyErr *dummyError
+	var pathErr *fs.PathError
+	var iface interface{ Timeout() bool }
+	var typedNil error = (*dummyError)(nil)
+
+	type args struct {
+		got    interface{}
+		target interface{}
+	}
+	tests := []struct {
+		name           string
+		args           args
+		want           bool
+		wantTestFailed bool
+		wantMessage    []string
+	}{
+		{
+			name:           "should pass when get error of target type",
+			args:           args{got: &dummyError{code: 1}, target: &dummyErr},
+			want:           true,
+			wantTestFailed: false,
+		},
+		{
+			name:           "should pass when get error wrapping error of target type",
+			args:           args{got: fmt.Errorf("wrapped: %w", &dummyError{code: 1}), target: &dummyErr},
+			want:           true,
+			wantTestFailed: false,
+		},
+		{
+			name:           "should fail when get error of other type",
+			args:           args{got: io.EOF, target: &pathErr},
+			want:           false,
+			wantTestFailed: true,
+			wantMessage:    []string{"Observed error chain must contain an error of type *fs.PathError"},
+		},
+		{
+			name:           "should fail when get error not implementing target interface",
+			args:           args{got: io.EOF, target: &iface},
+			want:           false,
+			wantTestFailed: true,
+		},
+		{
+			name:           "should fail when get nil",
+			args:           args{got: nil, target: &dummyErr},
+			want:           false,
+			wantTestFailed: true,
+			wantMessage:    []string{"Observed value must be a non-nil error"},
+		},
+		{
+			name:           "should fail when get typed nil",
+			args:           args{got: typedNil, target: &dummyErr},
+			want:           false,
+			wantTestFailed: true,
+			wantMessage:    []string{"Observed error must not be a non-nil interface holding a nil pointer (typed nil)"},
+		},
+		{
+			name:           "should fail when target is not a pointer",
+			args:           args{got: io.EOF, target: dummyErr},
+			want:           false,
+			wantTestFailed: true,
+			wantMessage:    []string{"Invalid argument"},
+		},
+		{
+			name:           "should fail when target points to non-error type",
+			args:           args{got: io.EOF, target: new(string)},
+			want:           false,
+			wantTestFailed: true,
+			wantMessage:    []string{"Invalid argument"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Given
+			assert := New(t)
+			dummyT := &fakeT{}
+			dummyAssert := New(dummyT)
+
+			// When
+			got := dummyAssert(tt.args.got).IsErrorOfType(tt.args.target)
+
+			// Then
+			assert(got).Equals(tt.want)
+			assert(dummyT.failed).Equals(tt.wantTestFailed)
+			for _, want := range tt.wantMessage {
+				assert(strings.Contains(strings.Join(dummyT.logs, "\n"), want)).IsTrue()
+			}
+		})
+	}
+}
+
+func TestIsErrorOfTypeSetsTarget(t *testing.T) {
+	// Given
+	assert := NewFatal(t)
+	var target *dummyError
+
+	// When
+	assert(fmt.Errorf("wrapped: %w", &dummyError{code: 7})).IsErrorOfType(&target)
+
+	// Then
+	assert(target.code).Equals(7)
+}
+
+func TestHasErrorMessage(t *testing.T) {
+	err := fmt.Errorf("open config: %w", errors.New("no such file"))
+	var typedNil error = (*dummyError)(nil)
+
+	type args struct {
+		got  interface{}
+		want string
+	}
+	tests := []struct {
+		name           string
+		args           args
+		want           bool
+		wantTestFailed bool
+		wantMessage    []string
+	}{
+		{
+			name:           "should pass when message equals",
+			args:           args{got: err, want: "open config: no such file"},
+			want:           true,
+			wantTestFailed: false,
+		},
+		{
+			name:           "should fail when message differs",
+			args:           args{got: err, want: "open config"},
+			want:           false,
+			wantTestFailed: true,
+			wantMessage:    []string{"Observed error message must equal expected message", "*errors.errorString: no such file"},
+		},
+		{
+			name:           "should fail when get nil",
+			args:           args{got: nil, want: ""},
+			want:           false,
+			wantTestFailed: true,
+			wantMessage:    []string{"Observed value must be a non-nil error"},
+		},
+		{
+			name:           "should fail when get typed nil",
+			args:           args{got: typedNil, want: ""},
+			want:           false,
+			wantTestFailed: true,
+			wantMessage:    []string{"Observed error must not be a non-nil interface holding a nil pointer (typed nil)"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Given
+			assert := New(t)
+			dummyT := &fakeT{}
+			dummyAssert := New(dummyT)
+
+			// When
+			got := dummyAssert(tt.args.got).HasErrorMessage(tt.args.want)
+
+			// Then
+			assert(got).Equals(tt.want)
+			assert(dummyT.failed).Equals(tt.wantTestFailed)
+			for _, want := range tt.wantMessage {
+				assert(strings.Contains(strings.Join(dummyT.logs, "\n"), want)).IsTrue()
+			}
+		})
+	}
+}
+
+func TestErrorMessageContains(t *testing.T) {
+	err := fmt.Errorf("open config: %w", errors.New("no such file"))
+	var typedNil error = (*dummyError)(nil)
+
+	type args struct {
+		got  interface{}
+		want string
+	}
+	tests := []struct {
+		name           string
+		args           args
+		want           bool
+		wantTestFailed bool
+		wantMessage    []string
+	}{
+		{
+			name:           "should pass when message contains substring",
+			args:           args{got: err, want: "no such"},
+			want:           true,
+			wantTestFailed: false,
+		},
+		{
+			name:           "should fail when message doesn't contain substring",
+			args:           args{got: err, want: "permission"},
+			want:           false,
+			wantTestFailed: true,
+			wantMessage:    []string{"Observed error message must contain expected substring"},
+		},
+		{
+			name:           "should fail when get typed nil",
+			args:           args{got: typedNil, want: ""},
+			want:           false,
+			wantTestFailed: true,
+			wantMessage:    []string{"Observed error must not be a non-nil interface holding a nil pointer (typed nil)"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Given
+			assert := New(t)
+			dummyT := &fakeT{}
+			dummyAssert := New(dummyT)
+
+			// When
+			got := dummyAssert(tt.args.got).ErrorMessageContains(tt.args.want)
+
+			// Then
+			assert(got).Equals(tt.want)
+			assert(dummyT.failed).Equals(tt.wantTestFailed)
+			for _, want := range tt.wantMessage {
+				assert(strings.Contains(strings.Join(dummyT.logs, "\n"), want)).IsTrue()
+			}
+		})
+	}
+}
+
+func TestErrorMessageMatches(t *testing.T) {
+	err := fmt.Errorf("open config: %w", errors.New("no such file"))
+	var typedNil error = (*dummyError)(nil)
+
+	type args struct {
+		got     interface{}
+		pattern interface{}
+	}
+	tests := []struct {
+		name           string
+		args           args
+		want           bool
+		wantTestFailed bool
+		wantMessage    []string
+	}{
+		{
+			name:           "should pass when message matches string pattern",
+			args:           args{got: err, pattern: `^open \w+: no such file$`},
+			want:           true,
+			wantTestFailed: false,
+		},
+		{
+			name:           "should pass when message matches compiled pattern",
+			args:           args{got: err, pattern: regexp.MustCompile(`config`)},
+			want:           true,
+			wantTestFailed: false,
+		},
+		{
+			name:           "should fail when message doesn't match pattern",
+			args:           args{got: err, pattern: `^config`},
+			want:           false,
+			wantTestFailed: true,
+			wantMessage:    []string{"Observed error message must match expected pattern"},
+		},
+		{
+			name:           "should fail when get typed nil",
+			args:           args{got: typedNil, pattern: `.*`},
+			want:           false,
+			wantTestFailed: true,
+			wantMessage:    []string{"Observed error must not be a non-nil interface holding a nil pointer (typed nil)"},
+		},
+		{
+			name:           "should fail when pattern is invalid",
+			args:           args{got: err, pattern: `(`},
+			want:           false,
+			wantTestFailed: true,
+			wantMessage:    []string{"Invalid argument"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Given
+			assert := New(t)
+			dummyT := &fakeT{}
+			dummyAssert := New(dummyT)
+
+			// When
+			got := dummyAssert(tt.args.got).ErrorMessageMatches(tt.args.pattern)
+
+			// Then
+			assert(got).Equals(tt.want)
+			assert(dummyT.failed).Equals(tt.wantTestFailed)
+			for _, want := range tt.wantMessage {
+				assert(strings.Contains(strings.Join(dummyT.logs, "\n"), want)).IsTrue()
+			}
+		})
+	}
+}
+
+func TestErrorChainDetails(t *testing.T) {
+	// Given
+	assert := New(t)
+	err := fmt.Errorf("outer: %w", &dummyError{code: 1})
+
+	// When
+	got := errorChainDetails(err)
+
+	// Then
+	assert(got).Equals(strings.Join([]string{
+		"Error chain:",
+		"  *fmt.wrapError: outer: dummy error 1",
+		"  *assert.dummyError: dummy error 1",
+	}, "\n"))
+}
+
+func TestErrorChainDetailsWithTypedNilLink(t *testing.T) {
+	// Given
+	assert := New(t)
+	err := fmt.Errorf("outer: %w", (*dummyError)(nil))
+
+	// When
+	got := errorChainDetails(err)
+
+	// Then
+	assert(got).Equals(strings.Join([]string{
+		"Error chain:",
+		"  *fmt.wrapError: outer: <nil>",
+		"  *assert.dummyError: <nil>",
+	}, "\n"))
+}
@@ -0,0 +1,280 @@
+package assert
+
+import (
+	"fmt"
+	"math"
+	"math/cmplx"
+	"reflect"
+	"strconv"
+)
+
+// number is a numeric value converted to a complex number, along with the precision of the
+// type it was converted from.
+type number struct {
+	value complex128
+	// bits is the size in bits of the type's floating-point components
+	bits int
+}
+
+func toNumber(v reflect.Value) (number, bool) {
+	if v.Type() == jsonNumberType {
+		f, err := strconv.ParseFloat(v.String(), 64)
+		return number{value: complex(f, 0), bits: 64}, err == nil
+	}
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return number{value: complex(float64(v.Int()), 0), bits: 64}, true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return number{value: complex(float64(v.Uint()), 0), bits: 64}, true
+	case reflect.Float32:
+		return number{value: complex(v.Float(), 0), bits: 32}, true
+	case reflect.Float64:
+		return number{value: complex(v.Float(), 0), bits: 64}, true
+	case reflect.Complex64:
+		return number{value: v.Complex(), bits: 32}, true
+	case reflect.Complex128:
+		return number{value: v.Complex(), bits: 64}, true
+	default:
+		return number{}, false
+	}
+}
+
+func floatNaNEqual(a, b float64) bool {
+	return a == b || math.IsNaN(a) && math.IsNaN(b)
+}
+
+func complexNaNEqual(a, b complex128) bool {
+	return floatNaNEqual(real(a), real(b)) && floatNaNEqual(imag(a), imag(b))
+}
+
+// absoluteDistance returns the absolute difference between got and want.
+func absoluteDistance(got, want number) float64 {
+	if got.value == want.value {
+		return 0
+	}
+	return cmplx.Abs(got.value - want.value)
+}
+
+// relativeDistance returns the absolute difference between got and want, relative to want.
+func relativeDistance(got, want number) float64 {
+	if got.value == want.value {
+		return 0
+	}
+	if want.value == 0 {
+		return math.Inf(1)
+	}
+	return cmplx.Abs(got.value-want.value) / cmplx.Abs(want.value)
+}
+
+// ulpDistance returns the number of representable floating-point values between got and want,
+// using the precision of the least precise of the two. For complex numbers, the largest distance
+// of the real and imaginary parts is returned.
+func ulpDistance(got, want number) float64 {
+	bits := got.bits
+	if want.bits < bits {
+		bits = want.bits
+	}
+	return math.Max(
+		ulpDistanceOf(real(got.value), real(want.value), bits),
+		ulpDistanceOf(imag(got.value), imag(want.value), bits),
+	)
+}
+
+func ulpDistanceOf(a, b float64, bits int) float64 {
+	if a == b {
+		return 0
+	}
+	if bits == 32 {
+		return math.Abs(float64(orderedBits32(float32(a)) - orderedBits32(float32(b))))
+	}
+	ordered1, ordered2 := orderedBits64(a), orderedBits64(b)
+	if ordered1 > ordered2 {
+		return float64(uint64(ordered1 - ordered2))
+	}
+	return float64(uint64(ordered2 - ordered1))
+}
+
+// orderedBits64 maps the float to an integer, such that adjacent floats map to adjacent integers.
+func orderedBits64(f float64) int64 {
+	b := int64(math.Float64bits(f))
+	if b < 0 {
+		b = math.MinInt64 - b
+	}
+	return b
+}
+
+// orderedBits32 maps the float to an integer, such that adjacent floats map to adjacent integers.
+func orderedBits32(f float32) int64 {
+	b := int64(int32(math.Float32bits(f)))
+	if b < 0 {
+		b = math.MinInt32 - b
+	}
+	return b
+}
+
+// approxCheck compares numbers, and collections of numbers element-wise, allowing them to differ
+// by a tolerance.
+type approxCheck struct {
+	// measure names the distance in failure messages, e.g. "delta"
+	measure   string
+	distance  func(got, want number) float64
+	tolerance float64
+	cfg       equalConfig
+	details   []string
+}
+
+// compare compares got and want, recording every element exceeding the tolerance. An error is
+// returned if the values can't be compared approximately.
+func (c *approxCheck) compare(path valuePath, got, want reflect.Value) error {
+	if got.Kind() == reflect.Interface && !got.IsNil() {
+		got = got.Elem()
+	}
+	if want.Kind() == reflect.Interface && !want.IsNil() {
+		want = want.Elem()
+	}
+	if !got.IsValid() || !want.IsValid() {
+		return fmt.Errorf("nil values can't be compared approximately")
+	}
+
+	gotNumber, gotIsNumber := toNumber(got)
+	wantNumber, wantIsNumber := toNumber(want)
+	switch {
+	case gotIsNumber && wantIsNumber:
+		c.compareNumbers(path, gotNumber, wantNumber)
+		return nil
+	case isListKind(got.Kind()) && isListKind(want.Kind()):
+		if got.Len() != want.Len() {
+			c.details = append(c.details, difference{
+				path: path.display + ".length", want: fmt.Sprint(want.Len()), got: fmt.Sprint(got.Len()),
+			}.String())
+			return nil
+		}
+		for i := 0; i < got.Len(); i++ {
+			if err := c.compare(path.index(i), got.Index(i), want.Index(i)); err != nil {
+				return err
+			}
+		}
+		return nil
+	case got.Kind() == reflect.Map && want.Kind() == reflect.Map:
+		return c.compareMaps(path, got, want)
+	default:
+		return fmt.Errorf("values of type %s and %s can't be compared approximately", got.Type(), want.Type())
+	}
+}
+
+func (c *approxCheck) compareMaps(path valuePath, got, want reflect.Value) error {
+	if got.Type().Key() != want.Type().Key() {
+		return fmt.Errorf("maps with keys of type %s and %s can't be compared", got.Type().Key(), want.Type().Key())
+	}
+	for _, key := range sortedKeys(want) {
+		gotElem := got.MapIndex(key)
+		if !gotElem.IsValid() {
+			c.details = append(c.details, difference{
+				path: path.key(key).display, want: formatValue(want.MapIndex(key)), missing: true,
+			}.String())
+			continue
+		}
+		if err := c.compare(path.key(key), gotElem, want.MapIndex(key)); err != nil {
+			return err
+		}
+	}
+	for _, key := range sortedKeys(got) {
+		if !want.MapIndex(key).IsValid() {
+			c.details = append(c.details, difference{
+				path: path.key(key).display, got: formatValue(got.MapIndex(key)), unexpected: true,
+			}.String())
+		}
+	}
+	return nil
+}
+
+func (c *approxCheck) compareNumbers(path valuePath, got, want number) {
+	var distance float64
+	if cmplx.IsNaN(got.value) || cmplx.IsNaN(want.value) {
+		if c.cfg.nanEqualsNaN && complexNaNEqual(got.value, want.value) {
+			return
+		}
+		distance = math.NaN()
+	} else {
+		distance = c.distance(got, want)
+	}
+	if distance <= c.tolerance {
+		return
+	}
+	gotStr, wantStr := formatNumber(got), formatNumber(want)
+	c.details = append(c.details, fmt.Sprintf("%s, %s %v", difference{path: path.display, want: wantStr, got: gotStr},
+		c.measure, distance))
+}
+
+func formatNumber(n number) string {
+	if imag(n.value) == 0 {
+		return fmt.Sprint(real(n.value))
+	}
+	return fmt.Sprint(n.value)
+}
+
+// assertApprox compares the observed value with the 'want' argument using the check and marks
+// the function under test as having failed if they differ by more than the tolerance.
+func (a asserter) assertApprox(want interface{}, check approxCheck, opts []EqualOption) bool {
+	cfg, err := newEqualConfig(opts)
+	if err == nil && (math.IsNaN(check.tolerance) || check.tolerance < 0) {
+		err = fmt.Errorf("%s tolerance must be non-negative, got %v", check.measure, check.tolerance)
+	}
+	if err == nil {
+		check.cfg = cfg
+		err = check.compare(valuePath{}, reflect.ValueOf(a.got), reflect.ValueOf(want))
+	}
+	if err != nil {
+		a.errorf(fmt.Sprintf("Invalid argument: %v", err), want, true)
+		return false
+	}
+	if len(check.details) > 0 {
+		details := append(check.details, fmt.Sprintf("Allowed %s: %v", check.measure, check.tolerance))
+		a.errorf(fmt.Sprintf("Observed value must be within %s %v of expected", check.measure, check.tolerance),
+			want, true, details...)
+		return false
+	}
+	return true
+}
+
+// InDelta asserts the observed value differs from the 'want' argument by at most 'delta'. Integer,
+// floating-point, complex and json.Number values are supported, where the difference between
+// complex values is their distance in the complex plane. Slices, arrays and maps of such values are compared
+// element-wise. NaN is unequal to every value unless the NaNEqualsNaN option is given. If the
+// difference is larger, the function under test is marked as having failed.
+//
+//	Example:
+//		assert(0.1 + 0.2).InDelta(0.3, 1e-9)
+func (a asserter) InDelta(want interface{}, delta float64, opts ...EqualOption) bool {
+	if a.navigationFailed {
+		return false
+	}
+	return a.assertApprox(want, approxCheck{measure: "delta", distance: absoluteDistance, tolerance: delta}, opts)
+}
+
+// InEpsilon asserts the relative error between the observed value and the 'want' argument,
+// |got - want| / |want|, is at most 'epsilon'. See the InDelta method for the supported types. If
+// the relative error is larger, the function under test is marked as having failed.
+//
+//	Example:
+//		assert(total).InEpsilon(1000.0, 0.01) // within 1%
+func (a asserter) InEpsilon(want interface{}, epsilon float64, opts ...EqualOption) bool {
+	if a.navigationFailed {
+		return false
+	}
+	return a.assertApprox(want, approxCheck{measure: "relative error", distance: relativeDistance, tolerance: epsilon},
+		opts)
+}
+
+// WithinULP asserts the observed value is at most 'ulps' units in the last place from the 'want'
+// argument, meaning there are at most that many representable floating-point values between them.
+// The precision of the least precise of the two values' types is used, so a float32 compared with
+// a float64 is compared as float32 values. See the InDelta method for the supported types. If the
+// distance is larger, the function under test is marked as having failed.
+func (a asserter) WithinULP(want interface{}, ulps uint64, opts ...EqualOption) bool {
+	if a.navigationFailed {
+		return false
+	}
+	return a.assertApprox(want, approxCheck{measure: "ULP distance", distance: ulpDistance, tolerance: float64(ulps)},
+		opts)
+}
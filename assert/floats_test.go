@@ -0,0 +1,273 @@
+package assert
+
+import (
+	"math"
+	"strings"
+	"testing"
+)
+
+func TestInDelta(t *testing.T) {
+	type args struct {
+		got   interface{}
+		want  interface{}
+		delta float64
+		opts  []EqualOption
+	}
+	tests := []struct {
+		name           string
+		args           args
+		want           bool
+		wantTestFailed bool
+		wantMessage    []string
+	}{
+		{
+			name:           "should pass when float sum within delta",
+			args:           args{got: 0.1 + 0.2, want: 0.3, delta: 1e-9},
+			want:           true,
+			wantTestFailed: false,
+		},
+		{
+			name:           "should fail when float outside delta",
+			args:           args{got: 0.31, want: 0.3, delta: 1e-9},
+			want:           false,
+			wantTestFailed: true,
+			wantMessage:    []string{"Observed value must be within delta 1e-09 of expected", "Allowed delta: 1e-09"},
+		},
+		{
+			name:           "should pass when float32 within delta of float64",
+			args:           args{got: float32(0.3), want: 0.3, delta: 1e-6},
+			want:           true,
+			wantTestFailed: false,
+		},
+		{
+			name:           "should pass when complex within delta",
+			args:           args{got: complex(1.0, 1.0), want: complex(1.0, 1.0+1e-12), delta: 1e-9},
+			want:           true,
+			wantTestFailed: false,
+		},
+		{
+			name:           "should fail when complex outside delta",
+			args:           args{got: complex64(complex(1, 1)), want: complex64(complex(1, 2)), delta: 0.5},
+			want:           false,
+			wantTestFailed: true,
+			wantMessage:    []string{"want (1+2i), got (1+1i), delta 1"},
+		},
+		{
+			name:           "should fail when NaN",
+			args:           args{got: math.NaN(), want: math.NaN(), delta: 1},
+			want:           false,
+			wantTestFailed: true,
+			wantMessage:    []string{"want NaN, got NaN, delta NaN"},
+		},
+		{
+			name:           "should pass when NaN and NaN equals NaN",
+			args:           args{got: math.NaN(), want: math.NaN(), delta: 1, opts: []EqualOption{NaNEqualsNaN()}},
+			want:           true,
+			wantTestFailed: false,
+		},
+		{
+			name:           "should pass when same infinity",
+			args:           args{got: math.Inf(1), want: math.Inf(1), delta: 0},
+			want:           true,
+			wantTestFailed: false,
+		},
+		{
+			name:           "should fail when delta is negative",
+			args:           args{got: 1.0, want: 1.0, delta: -1},
+			want:           false,
+			wantTestFailed: true,
+			wantMessage:    []string{"Invalid argument: delta tolerance must be non-negative, got -1"},
+		},
+		{
+			name:           "should fail when get non-number",
+			args:           args{got: "1.0", want: 1.0, delta: 1},
+			want:           false,
+			wantTestFailed: true,
+			wantMessage:    []string{"Invalid argument: values of type string and float64 can't be compared approximately"},
+		},
+		{
+			name:           "should pass when slice elements within delta",
+			args:           args{got: []float64{1, 2.0000001}, want: []float64{1, 2}, delta: 1e-6},
+			want:           true,
+			wantTestFailed: false,
+		},
+		{
+			name:           "should fail when slice element outside delta",
+			args:           args{got: []float64{1, 2.5}, want: []float64{1, 2}, delta: 0.1},
+			want:           false,
+			wantTestFailed: true,
+			wantMessage:    []string{"[1]: want 2, got 2.5, delta 0.5"},
+		},
+		{
+			name:           "should fail when slices have different lengths",
+			args:           args{got: []float64{1}, want: []float64{1, 2}, delta: 1e-6},
+			want:           false,
+			wantTestFailed: true,
+			wantMessage:    []string{".length: want 2, got 1"},
+		},
+		{
+			name:           "should pass when map values within delta",
+			args:           args{got: map[string]float32{"a": 1.0000001}, want: map[string]float32{"a": 1}, delta: 1e-6},
+			want:           true,
+			wantTestFailed: false,
+		},
+		{
+			name:           "should fail when map keys differ",
+			args:           args{got: map[string]float64{"a": 1}, want: map[string]float64{"b": 1}, delta: 1e-6},
+			want:           false,
+			wantTestFailed: true,
+			wantMessage:    []string{`["b"]: missing, want 1`, `["a"]: unexpected, got 1`},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Given
+			assert := New(t)
+			dummyT := &fakeT{}
+			dummyAssert := New(dummyT)
+
+			// When
+			got := dummyAssert(tt.args.got).InDelta(tt.args.want, tt.args.delta, tt.args.opts...)
+
+			// Then
+			assert(got).Equals(tt.want)
+			assert(dummyT.failed).Equals(tt.wantTestFailed)
+			for _, want := range tt.wantMessage {
+				assert(strings.Contains(strings.Join(dummyT.logs, "\n"), want)).IsTrue()
+			}
+		})
+	}
+}
+
+func TestInEpsilon(t *testing.T) {
+	type args struct {
+		got     interface{}
+		want    interface{}
+		epsilon float64
+	}
+	tests := []struct {
+		name           string
+		args           args
+		want           bool
+		wantTestFailed bool
+		wantMessage    []string
+	}{
+		{
+			name:           "should pass when within relative error",
+			args:           args{got: 1005.0, want: 1000.0, epsilon: 0.01},
+			want:           true,
+			wantTestFailed: false,
+		},
+		{
+			name:           "should fail when outside relative error",
+			args:           args{got: 1020.0, want: 1000.0, epsilon: 0.01},
+			want:           false,
+			wantTestFailed: true,
+			wantMessage: []string{
+				"Observed value must be within relative error 0.01 of expected",
+				"want 1000, got 1020, relative error 0.02",
+			},
+		},
+		{
+			name:           "should fail when want zero and get non-zero",
+			args:           args{got: 1e-300, want: 0.0, epsilon: 0.01},
+			want:           false,
+			wantTestFailed: true,
+			wantMessage:    []string{"want 0, got 1e-300, relative error +Inf"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Given
+			assert := New(t)
+			dummyT := &fakeT{}
+			dummyAssert := New(dummyT)
+
+			// When
+			got := dummyAssert(tt.args.got).InEpsilon(tt.args.want, tt.args.epsilon)
+
+			// Then
+			assert(got).Equals(tt.want)
+			assert(dummyT.failed).Equals(tt.wantTestFailed)
+			for _, want := range tt.wantMessage {
+				assert(strings.Contains(strings.Join(dummyT.logs, "\n"), want)).IsTrue()
+			}
+		})
+	}
+}
+
+func TestWithinULP(t *testing.T) {
+	type args struct {
+		got  interface{}
+		want interface{}
+		ulps uint64
+	}
+	tests := []struct {
+		name           string
+		args           args
+		want           bool
+		wantTestFailed bool
+		wantMessage    []string
+	}{
+		{
+			name:           "should pass when adjacent floats within one ULP",
+			args:           args{got: math.Nextafter(1, 2), want: 1.0, ulps: 1},
+			want:           true,
+			wantTestFailed: false,
+		},
+		{
+			name:           "should fail when floats two ULPs apart and want one",
+			args:           args{got: math.Nextafter(math.Nextafter(1, 2), 2), want: 1.0, ulps: 1},
+			want:           false,
+			wantTestFailed: true,
+			wantMessage:    []string{"Observed value must be within ULP distance 1 of expected", "ULP distance 2"},
+		},
+		{
+			name:           "should pass when floats of opposite signs around zero within ULPs",
+			args:           args{got: math.Copysign(0, -1), want: 0.0, ulps: 0},
+			want:           true,
+			wantTestFailed: false,
+		},
+		{
+			name:           "should pass when float32 compared with float64 in float32 precision",
+			args:           args{got: float32(0.1), want: 0.1, ulps: 0},
+			want:           true,
+			wantTestFailed: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Given
+			assert := New(t)
+			dummyT := &fakeT{}
+			dummyAssert := New(dummyT)
+
+			// When
+			got := dummyAssert(tt.args.got).WithinULP(tt.args.want, tt.args.ulps)
+
+			// Then
+			assert(got).Equals(tt.want)
+			assert(dummyT.failed).Equals(tt.wantTestFailed)
+			for _, want := range tt.wantMessage {
+				assert(strings.Contains(strings.Join(dummyT.logs, "\n"), want)).IsTrue()
+			}
+		})
+	}
+}
+
+func TestEqualsWithNaNEqualsNaN(t *testing.T) {
+	// Given
+	assert := New(t)
+	type point struct {
+		X, Y float64
+	}
+	dummyAssert := New(&fakeT{})
+
+	// When
+	withoutOption := dummyAssert(point{X: math.NaN()}).Equals(point{X: math.NaN()})
+	withOption := dummyAssert(point{X: math.NaN()}).Equals(point{X: math.NaN()}, NaNEqualsNaN())
+
+	// Then
+	assert(withoutOption).IsFalse()
+	assert(withOption).IsTrue()
+}
@@ -2,6 +2,7 @@ package assert
 
 import (
 	"bytes"
+	"fmt"
 	"runtime"
 	"strings"
 	"text/template"
@@ -14,7 +15,10 @@ Assertion failed!
 	Description: {{.Msg}}
 	Expected: {{.Want}}
 	Observed: {{.Got}}
-
+{{if .Details}}
+Details:{{range .Details}}
+	{{.}}{{end}}
+{{end}}
 Call stack:
 {{range .Entries}}
 	{{.Filename}}.{{.Line}}: {{.FuncName}}{{end}}
@@ -51,10 +55,15 @@ func rawCallStack() []callStackEntry {
 			continue
 		}
 
-		if funcName == "testing.tRunner" {
+		if isTestRunner(funcName) {
 			break
 		}
 
+		// Fuzz targets are invoked by the testing package through reflection
+		if strings.HasPrefix(funcName, "reflect.") {
+			continue
+		}
+
 		callStack = append(callStack, callStackEntry{
 			Filename: filename,
 			FuncName: funcName,
@@ -64,6 +73,14 @@ func rawCallStack() []callStackEntry {
 	return callStack
 }
 
+// isTestRunner reports whether funcName is one of the testing package functions that run
+// tests, benchmarks and fuzz targets.
+func isTestRunner(funcName string) bool {
+	return funcName == "testing.tRunner" ||
+		strings.HasPrefix(funcName, "testing.(*B).") ||
+		strings.HasPrefix(funcName, "testing.(*F).")
+}
+
 func callStack() []callStackEntry {
 	var formattedCallStack []callStackEntry
 	for _, rawEntry := range rawCallStack() {
@@ -81,13 +98,14 @@ func callStack() []callStackEntry {
 	return formattedCallStack
 }
 
-func errorMsg(msg string, want, got interface{}, assertHasWantParam bool) string {
+func errorMsg(msg string, want, got interface{}, assertHasWantParam bool, details ...string) string {
 	var buf bytes.Buffer
 
 	type messageValues struct {
 		Msg     string
 		Want    interface{}
 		Got     interface{}
+		Details []string
 		Entries []callStackEntry
 	}
 
@@ -98,24 +116,63 @@ func errorMsg(msg string, want, got interface{}, assertHasWantParam bool) string
 		Entries: callStack(),
 	}
 
+	// Multi-line details are indented so they line up under the Details heading
+	for _, detail := range details {
+		msgValues.Details = append(msgValues.Details, indent(detail))
+	}
+
+	want, got = displayedValues(want, got, assertHasWantParam)
+	msgValues.Want, msgValues.Got = indentValue(want), indentValue(got)
+
+	if err := preparsedMessageTmpl.Execute(&buf, msgValues); err != nil {
+		panic(err)
+	}
+
+	return buf.String()
+}
+
+// displayedValues returns the expected and observed values as they're shown in failure messages.
+func displayedValues(want, got interface{}, assertHasWantParam bool) (interface{}, interface{}) {
 	if !assertHasWantParam {
-		msgValues.Want = "N/A"
+		want = "N/A"
 	}
 
 	if isFunc(want) {
-		msgValues.Want = "function"
+		want = "function"
 	} else if isChan(want) {
-		msgValues.Want = "chan"
+		want = "chan"
 	}
 	if isFunc(got) {
-		msgValues.Got = "function"
+		got = "function"
 	} else if isChan(got) {
-		msgValues.Got = "chan"
+		got = "chan"
 	}
+	return want, got
+}
 
-	if err := preparsedMessageTmpl.Execute(&buf, msgValues); err != nil {
-		panic(err)
+// failureDescription returns a compact description of a failure, without the call stack. It's
+// used for failures that are only reported as details of another failure.
+func failureDescription(msg string, want, got interface{}, assertHasWantParam bool, details ...string) string {
+	want, got = displayedValues(want, got, assertHasWantParam)
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s\n\tExpected: %v\n\tObserved: %v", msg, indentValue(want), indentValue(got))
+	for _, detail := range details {
+		b.WriteString("\n\t" + indent(detail))
 	}
+	return b.String()
+}
 
-	return buf.String()
+// indent indents every line of the text but the first one, so multi-line values and details line
+// up with the rest of the failure message.
+func indent(text string) string {
+	return strings.ReplaceAll(text, "\n", "\n\t")
+}
+
+// indentValue returns the value as indented text if it's formatted on several lines. Otherwise,
+// it's returned unchanged.
+func indentValue(v interface{}) interface{} {
+	if text := fmt.Sprint(v); strings.Contains(text, "\n") {
+		return indent(text)
+	}
+	return v
 }
@@ -0,0 +1,489 @@
+package assert
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// JSONOption configures how JSON documents are decoded and compared.
+type JSONOption func(*jsonConfig)
+
+type jsonConfig struct {
+	// strictEmpty makes empty input an error instead of an empty object
+	strictEmpty bool
+	// exactNumbers decodes numbers as json.Number and compares them as exact decimals
+	exactNumbers bool
+	// unorderedArrays holds the JSON Pointers of the arrays whose order is ignored
+	unorderedArrays     []string
+	rejectDuplicateKeys bool
+	err                 error
+}
+
+func newJSONConfig(opts []JSONOption) (jsonConfig, error) {
+	var cfg jsonConfig
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	return cfg, cfg.err
+}
+
+// ignoresOrderAt reports whether the order of the array at the JSON Pointer 'path' is ignored.
+func (cfg jsonConfig) ignoresOrderAt(path string) bool {
+	for _, pattern := range cfg.unorderedArrays {
+		if matchesJSONPointer(pattern, path) {
+			return true
+		}
+	}
+	return false
+}
+
+// matchesJSONPointer reports whether the JSON Pointer 'path' matches 'pattern', in which the
+// reference token "*" matches any object key or array index.
+func matchesJSONPointer(pattern, path string) bool {
+	patternTokens, pathTokens := strings.Split(pattern, "/"), strings.Split(path, "/")
+	if len(patternTokens) != len(pathTokens) {
+		return false
+	}
+	for i, token := range patternTokens {
+		if token != "*" && token != pathTokens[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// StrictEmptyJSON makes empty JSON input invalid. By default, empty input is treated as an empty
+// object.
+func StrictEmptyJSON() JSONOption {
+	return func(cfg *jsonConfig) {
+		cfg.strictEmpty = true
+	}
+}
+
+// ExactJSONNumbers compares numbers as exact decimals, so 9007199254740993 doesn't equal
+// 9007199254740992, while 1.0 still equals 1. By default, numbers are decoded as float64 values.
+func ExactJSONNumbers() JSONOption {
+	return func(cfg *jsonConfig) {
+		cfg.exactNumbers = true
+	}
+}
+
+// IgnoreJSONArrayOrder ignores the order of the elements of the arrays at the given JSON Pointers,
+// which are compared as multisets instead. In a path, the reference token "*" matches any object
+// key or array index.
+//
+//	Example:
+//		assert(body).IsJSONEqualTo(want, assert.IgnoreJSONArrayOrder("/tags", "/orders/*/items"))
+func IgnoreJSONArrayOrder(paths ...string) JSONOption {
+	return func(cfg *jsonConfig) {
+		for _, path := range paths {
+			if path != "" && !strings.HasPrefix(path, "/") {
+				cfg.err = fmt.Errorf("invalid JSON Pointer %q, must be empty or start with /", path)
+				return
+			}
+		}
+		cfg.unorderedArrays = append(cfg.unorderedArrays, paths...)
+	}
+}
+
+// RejectDuplicateJSONKeys makes JSON text with duplicate keys in an object invalid. By default,
+// the last value of a duplicated key is used.
+func RejectDuplicateJSONKeys() JSONOption {
+	return func(cfg *jsonConfig) {
+		cfg.rejectDuplicateKeys = true
+	}
+}
+
+// jsonTree decodes 'v' into the tree of maps, slices, strings, float64s, bools and nils that
+// encoding/json produces when unmarshalling into an interface{} value. Numbers are decoded as
+// json.Number values if exact numbers are configured. Strings, byte slices (including
+// json.RawMessage) and io.Readers are parsed as JSON text, and any other value is marshalled
+// first. Empty JSON text is treated as an empty object, unless configured otherwise.
+func jsonTree(v interface{}, cfg jsonConfig) (interface{}, error) {
+	data, err := jsonText(v)
+	if err != nil {
+		return nil, err
+	}
+	if len(data) == 0 {
+		if cfg.strictEmpty {
+			return nil, errors.New("empty JSON input")
+		}
+		data = []byte("{}")
+	}
+
+	var tree interface{}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	if cfg.exactNumbers {
+		decoder.UseNumber()
+	}
+	if err := decoder.Decode(&tree); err != nil {
+		return nil, fmt.Errorf("could not JSON-unmarshal: %w", err)
+	}
+	if _, err := decoder.Token(); err != io.EOF {
+		return nil, errors.New("could not JSON-unmarshal: unexpected data after top-level value")
+	}
+	if cfg.rejectDuplicateKeys {
+		if err := checkDuplicateKeys(json.NewDecoder(bytes.NewReader(data)), ""); err != nil {
+			return nil, err
+		}
+	}
+	return tree, nil
+}
+
+// checkDuplicateKeys reads the next JSON value from 'decoder', whose JSON Pointer is 'path', and
+// returns an error if any object in it has duplicate keys.
+func checkDuplicateKeys(decoder *json.Decoder, path string) error {
+	token, err := decoder.Token()
+	if err != nil {
+		return err
+	}
+	switch token {
+	case json.Delim('{'):
+		seen := make(map[string]bool)
+		for decoder.More() {
+			token, err := decoder.Token()
+			if err != nil {
+				return err
+			}
+			key := token.(string)
+			keyPath := path + "/" + escapeJSONPointer(key)
+			if seen[key] {
+				return fmt.Errorf("duplicate JSON key at %s", keyPath)
+			}
+			seen[key] = true
+			if err := checkDuplicateKeys(decoder, keyPath); err != nil {
+				return err
+			}
+		}
+	case json.Delim('['):
+		for i := 0; decoder.More(); i++ {
+			if err := checkDuplicateKeys(decoder, path+"/"+strconv.Itoa(i)); err != nil {
+				return err
+			}
+		}
+	default:
+		return nil
+	}
+	// Reads the closing delimiter
+	_, err = decoder.Token()
+	return err
+}
+
+// jsonText returns the JSON text of 'v'.
+func jsonText(v interface{}) ([]byte, error) {
+	if text, ok := rawText(v); ok {
+		return []byte(text), nil
+	}
+	if reader, ok := v.(io.Reader); ok {
+		if isTypedNil(v) {
+			return nil, fmt.Errorf("could not read: reader is a nil %T", v)
+		}
+		data, err := io.ReadAll(reader)
+		if err != nil {
+			return nil, fmt.Errorf("could not read: %w", err)
+		}
+		return data, nil
+	}
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, fmt.Errorf("could not JSON-marshal: %w", err)
+	}
+	return data, nil
+}
+
+// Placeholders that can be used as string values in the expected document of IsJSONMatching.
+const (
+	jsonAnyPlaceholder         = "<<ANY>>"
+	jsonUUIDPlaceholder        = "<<UUID>>"
+	jsonRFC3339Placeholder     = "<<RFC3339>>"
+	jsonRegexPlaceholderPrefix = "<<REGEX:"
+	jsonPlaceholderSuffix      = ">>"
+)
+
+var uuidRegexp = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+
+// IsJSONMatching asserts the observed value is valid JSON and that it matches the 'want' argument,
+// which only needs to list the nodes of interest. Both sides are accepted in the same forms, and
+// can be decoded and compared with the same options, as in the IsJSONEqualTo method.
+//
+// The documents are compared like in the IsJSONEqualTo method, except that:
+//   - object keys that are only in the observed document are ignored, at any depth
+//   - string values in 'want' may be one of the following placeholders:
+//     "<<ANY>>" matches any value, but the key must exist,
+//     "<<UUID>>" matches a string holding a UUID,
+//     "<<RFC3339>>" matches a string holding an RFC 3339 timestamp and
+//     "<<REGEX:pattern>>" matches a string matching the regular expression 'pattern'
+//
+// Arrays must have the same length in both documents. If the documents don't match, the function
+// under test is marked as having failed.
+//
+//	Example:
+//		assert(body).IsJSONMatching(`{"id": "<<UUID>>", "name": "alice", "createdAt": "<<RFC3339>>"}`)
+func (a asserter) IsJSONMatching(want interface{}, opts ...JSONOption) bool {
+	if a.navigationFailed {
+		return false
+	}
+	if want == nil {
+		a.errorf("Invalid argument: expected value must not be nil", want, true)
+		return false
+	}
+	return a.compareJSON(want, true, "Observed JSON must match the nodes specified in expected JSON", opts)
+}
+
+// compareJSON decodes the observed value and 'want' into JSON trees and compares them. If they
+// differ, the function under test is marked as having failed with 'msg'.
+func (a asserter) compareJSON(want interface{}, partial bool, msg string, opts []JSONOption) bool {
+	cfg, err := newJSONConfig(opts)
+	if err != nil {
+		a.errorf(fmt.Sprintf("Invalid argument: %v", err), want, true)
+		return false
+	}
+	got1, err := jsonTree(a.observedJSON(), cfg)
+	if err != nil {
+		a.errorf(fmt.Sprintf("Observed value must be JSON: %v", err), want, true)
+		return false
+	}
+	want1, err := jsonTree(want, cfg)
+	if err != nil {
+		a.errorf(fmt.Sprintf("Expected value must be JSON: %v", err), want, true)
+		return false
+	}
+
+	d := jsonDiffer{cfg: cfg, partial: partial}
+	d.walk("", want1, got1)
+	if d.err != nil {
+		a.errorf(fmt.Sprintf("Invalid argument: %v", d.err), want, true)
+		return false
+	}
+	if len(d.diffs) > 0 {
+		canonical := a
+		canonical.got = compactJSON(got1)
+		canonical.errorf(msg, compactJSON(want1), true, formatJSONDifferences(d.diffs, want1, got1)...)
+		return false
+	}
+	return true
+}
+
+// observedJSON returns the observed value in a form accepted by jsonTree. Values selected from a
+// JSON document are JSON trees, which are encoded again, so that strings aren't taken for JSON
+// texts.
+func (a asserter) observedJSON() interface{} {
+	if a.jsonCfg == nil {
+		return a.got
+	}
+	data, err := json.Marshal(a.got)
+	if err != nil {
+		return a.got
+	}
+	return data
+}
+
+// jsonDiffer walks two JSON trees and collects the differences between them.
+type jsonDiffer struct {
+	cfg   jsonConfig
+	diffs []difference
+	// partial ignores object keys only in 'got', and enables placeholders in 'want'
+	partial bool
+	// err is set if 'want' holds an invalid placeholder
+	err error
+}
+
+// walk collects the differences between the JSON trees 'want' and 'got', found at the JSON
+// Pointer 'path'. The path of each difference is a JSON Pointer too, e.g. /items/3/price.
+func (d *jsonDiffer) walk(path string, want, got interface{}) {
+	switch w := want.(type) {
+	case map[string]interface{}:
+		if g, ok := got.(map[string]interface{}); ok {
+			keys := make([]string, 0, len(w)+len(g))
+			for key := range w {
+				keys = append(keys, key)
+			}
+			if !d.partial {
+				for key := range g {
+					if _, ok := w[key]; !ok {
+						keys = append(keys, key)
+					}
+				}
+			}
+			sort.Strings(keys)
+			for _, key := range keys {
+				keyPath := path + "/" + escapeJSONPointer(key)
+				wantValue, inWant := w[key]
+				gotValue, inGot := g[key]
+				switch {
+				case !inGot:
+					d.report(difference{path: keyPath, want: compactJSON(wantValue), missing: true})
+				case !inWant:
+					d.report(difference{path: keyPath, got: compactJSON(gotValue), unexpected: true})
+				default:
+					d.walk(keyPath, wantValue, gotValue)
+				}
+			}
+			return
+		}
+	case []interface{}:
+		if g, ok := got.([]interface{}); ok {
+			if d.cfg.ignoresOrderAt(path) {
+				d.walkUnordered(path, w, g)
+				return
+			}
+			for i := 0; i < len(w) || i < len(g); i++ {
+				elemPath := path + "/" + strconv.Itoa(i)
+				switch {
+				case i >= len(g):
+					d.report(difference{path: elemPath, want: compactJSON(w[i]), missing: true})
+				case i >= len(w):
+					d.report(difference{path: elemPath, got: compactJSON(g[i]), unexpected: true})
+				default:
+					d.walk(elemPath, w[i], g[i])
+				}
+			}
+			return
+		}
+	case string:
+		if d.partial && isJSONPlaceholder(w) {
+			matched, err := matchesJSONPlaceholder(w, got)
+			if err != nil && d.err == nil {
+				d.err = fmt.Errorf("%s: %w", path, err)
+			}
+			if !matched {
+				d.report(difference{path: path, want: compactJSON(want), got: compactJSON(got)})
+			}
+			return
+		}
+	case json.Number:
+		if g, ok := got.(json.Number); ok {
+			if !numbersEqual(w, g) {
+				d.report(difference{path: path, want: compactJSON(want), got: compactJSON(got)})
+			}
+			return
+		}
+	}
+
+	if !reflect.DeepEqual(want, got) {
+		d.report(difference{path: path, want: compactJSON(want), got: compactJSON(got)})
+	}
+}
+
+// walkUnordered collects the differences between the arrays 'want' and 'got' compared as
+// multisets. Every element of 'want' is paired with an unpaired element of 'got' without
+// differences, like in the IgnoringOrderEqualsElementsIn method, and the elements left unpaired
+// are reported.
+func (d *jsonDiffer) walkUnordered(path string, want, got []interface{}) {
+	// The differ is reused for every comparison of a want and a got element
+	elemDiffer := jsonDiffer{cfg: d.cfg, partial: d.partial}
+	m := newElementMatching(len(want), len(got), func(w, g int) bool {
+		elemDiffer.diffs = elemDiffer.diffs[:0]
+		elemDiffer.walk(path+"/"+strconv.Itoa(w), want[w], got[g])
+		return len(elemDiffer.diffs) == 0
+	})
+	unmatched := m.match()
+	if elemDiffer.err != nil && d.err == nil {
+		d.err = elemDiffer.err
+	}
+
+	for _, w := range unmatched {
+		d.report(difference{path: path + "/" + strconv.Itoa(w), want: compactJSON(want[w]), missing: true})
+	}
+	for g, w := range m.wantOf {
+		if w < 0 {
+			d.report(difference{path: path + "/" + strconv.Itoa(g), got: compactJSON(got[g]), unexpected: true})
+		}
+	}
+}
+
+// numbersEqual compares JSON numbers as exact decimals.
+func numbersEqual(want, got json.Number) bool {
+	wantRat, wantOK := new(big.Rat).SetString(want.String())
+	gotRat, gotOK := new(big.Rat).SetString(got.String())
+	if !wantOK || !gotOK {
+		return want == got
+	}
+	return wantRat.Cmp(gotRat) == 0
+}
+
+func (d *jsonDiffer) report(diff difference) {
+	d.diffs = append(d.diffs, diff)
+}
+
+func isJSONPlaceholder(s string) bool {
+	switch s {
+	case jsonAnyPlaceholder, jsonUUIDPlaceholder, jsonRFC3339Placeholder:
+		return true
+	}
+	return strings.HasPrefix(s, jsonRegexPlaceholderPrefix) && strings.HasSuffix(s, jsonPlaceholderSuffix)
+}
+
+// matchesJSONPlaceholder reports whether the JSON node 'got' matches 'placeholder'. An error is
+// returned if the placeholder holds an invalid regular expression.
+func matchesJSONPlaceholder(placeholder string, got interface{}) (bool, error) {
+	if placeholder == jsonAnyPlaceholder {
+		return true, nil
+	}
+	text, ok := got.(string)
+	if !ok {
+		return false, nil
+	}
+
+	switch placeholder {
+	case jsonUUIDPlaceholder:
+		return uuidRegexp.MatchString(text), nil
+	case jsonRFC3339Placeholder:
+		_, err := time.Parse(time.RFC3339, text)
+		return err == nil, nil
+	}
+
+	pattern := strings.TrimSuffix(strings.TrimPrefix(placeholder, jsonRegexPlaceholderPrefix), jsonPlaceholderSuffix)
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return false, fmt.Errorf("invalid regular expression in placeholder %q: %w", placeholder, err)
+	}
+	return re.MatchString(text), nil
+}
+
+// escapeJSONPointer escapes a reference token of a JSON Pointer as described in RFC 6901.
+func escapeJSONPointer(token string) string {
+	return strings.NewReplacer("~", "~0", "/", "~1").Replace(token)
+}
+
+// compactJSON returns the JSON text of the JSON tree 'v' on a single line, with object keys
+// sorted.
+func compactJSON(v interface{}) string {
+	return marshalJSON(v, "")
+}
+
+// prettyJSON returns the JSON text of the JSON tree 'v' indented, with object keys sorted.
+func prettyJSON(v interface{}) string {
+	return marshalJSON(v, "  ")
+}
+
+func marshalJSON(v interface{}, indent string) string {
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+	encoder.SetIndent("", indent)
+	if err := encoder.Encode(v); err != nil {
+		return fmt.Sprintf("%v", v)
+	}
+	return strings.TrimSuffix(buf.String(), "\n")
+}
+
+// formatJSONDifferences returns the differences between the JSON trees 'want' and 'got' followed
+// by both documents, as failure message details.
+func formatJSONDifferences(diffs []difference, want, got interface{}) []string {
+	details := formatDifferences(diffs)
+	return append(details,
+		"Expected document:\n"+prettyJSON(want),
+		"Observed document:\n"+prettyJSON(got),
+	)
+}
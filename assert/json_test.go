@@ -0,0 +1,583 @@
+package assert
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+)
+
+type failingReader struct{}
+
+func (failingReader) Read([]byte) (int, error) {
+	return 0, errors.New("connection reset")
+}
+
+type jsonTestUser struct {
+	Name string   `json:"name"`
+	Tags []string `json:"tags,omitempty"`
+}
+
+const jsonTestUserJSON = `{"tags": ["admin"], "name": "alice"}`
+
+const jsonTestObserved = `{
+	"id": "7f9c24e8-3b12-4fef-91e0-3a6ea9d4b2c1",
+	"name": "alice",
+	"createdAt": "2024-05-01T12:30:00.123Z",
+	"address": {"city": "Oslo", "zip": "0150"},
+	"tags": ["admin", "ops"],
+	"version": 3
+}`
+
+func TestIsJSONEqualToWithGoValuesAndOptions(t *testing.T) {
+	type args struct {
+		got  interface{}
+		want interface{}
+		opts []JSONOption
+	}
+	tests := []struct {
+		name           string
+		args           args
+		want           bool
+		wantTestFailed bool
+		wantMessage    []string
+	}{
+		{
+			name:           "should pass when want struct and get equal JSON string",
+			args:           args{got: jsonTestUserJSON, want: jsonTestUser{Name: "alice", Tags: []string{"admin"}}},
+			want:           true,
+			wantTestFailed: false,
+		},
+		{
+			name:           "should pass when want JSON string and get struct",
+			args:           args{got: &jsonTestUser{Name: "alice", Tags: []string{"admin"}}, want: jsonTestUserJSON},
+			want:           true,
+			wantTestFailed: false,
+		},
+		{
+			name:           "should fail when want struct and get other JSON string",
+			args:           args{got: `{"name": "bob"}`, want: jsonTestUser{Name: "alice"}},
+			want:           false,
+			wantTestFailed: true,
+			wantMessage:    []string{`/name: want "alice", got "bob"`},
+		},
+		{
+			name: "should report differences with expected document",
+			args: args{
+				got:  `{"items": [{"price": 12, "sku": "A1"}]}`,
+				want: `{"items": [{"sku": "A1", "price": 10}]}`,
+			},
+			want:           false,
+			wantTestFailed: true,
+			wantMessage: []string{
+				`Expected: {"items":[{"price":10,"sku":"A1"}]}`,
+				`Observed: {"items":[{"price":12,"sku":"A1"}]}`,
+				"/items/0/price: want 10, got 12",
+				"Expected document:\n\t{\n\t  \"items\": [\n\t    {\n\t      \"price\": 10,\n\t      \"sku\": \"A1\"",
+			},
+		},
+		{
+			name: "should pass when want map and get raw message",
+			args: args{
+				got:  json.RawMessage(jsonTestUserJSON),
+				want: map[string]interface{}{"name": "alice", "tags": []string{"admin"}},
+			},
+			want:           true,
+			wantTestFailed: false,
+		},
+		{
+			name: "should pass when want raw message and get reader",
+			args: args{
+				got:  strings.NewReader(jsonTestUserJSON),
+				want: json.RawMessage(`{"name":"alice","tags":["admin"]}`),
+			},
+			want:           true,
+			wantTestFailed: false,
+		},
+		{
+			name:           "should pass when want reader and get buffer",
+			args:           args{got: bytes.NewBufferString(`[1, 2]`), want: io.NopCloser(strings.NewReader(`[1,2]`))},
+			want:           true,
+			wantTestFailed: false,
+		},
+		{
+			name:           "should fail when get failing reader",
+			args:           args{got: failingReader{}, want: jsonTestUserJSON},
+			want:           false,
+			wantTestFailed: true,
+			wantMessage:    []string{"Observed value must be JSON: could not read: connection reset"},
+		},
+		{
+			name:           "should fail when get value that can't be marshalled",
+			args:           args{got: map[string]interface{}{"ch": make(chan int)}, want: jsonTestUserJSON},
+			want:           false,
+			wantTestFailed: true,
+			wantMessage:    []string{"Observed value must be JSON"},
+		},
+		{
+			name:           "should fail when want value that can't be marshalled",
+			args:           args{got: jsonTestUserJSON, want: func() {}},
+			want:           false,
+			wantTestFailed: true,
+			wantMessage:    []string{"Expected value must be JSON"},
+		},
+		{
+			name:           "should pass when get empty input by default",
+			args:           args{got: "", want: `{}`},
+			want:           true,
+			wantTestFailed: false,
+		},
+		{
+			name:           "should fail when get empty input with strict empty handling",
+			args:           args{got: "", want: `{}`, opts: []JSONOption{StrictEmptyJSON()}},
+			want:           false,
+			wantTestFailed: true,
+			wantMessage:    []string{"Observed value must be JSON: empty JSON input"},
+		},
+		{
+			name:           "should pass when want and get nil byte slices by default",
+			args:           args{got: []byte(nil), want: []byte(nil)},
+			want:           true,
+			wantTestFailed: false,
+		},
+		{
+			name:           "should pass when want nil and get nil byte slice",
+			args:           args{got: []byte(nil), want: nil},
+			want:           true,
+			wantTestFailed: false,
+		},
+		{
+			name:           "should pass when want nil byte slice and get nil",
+			args:           args{got: nil, want: []byte(nil)},
+			want:           true,
+			wantTestFailed: false,
+		},
+		{
+			name:           "should fail when want nil and get nil byte slice with strict empty handling",
+			args:           args{got: []byte(nil), want: nil, opts: []JSONOption{StrictEmptyJSON()}},
+			want:           false,
+			wantTestFailed: true,
+		},
+		{
+			name:           "should fail when want and get nil byte slices with strict empty handling",
+			args:           args{got: []byte(nil), want: []byte(nil), opts: []JSONOption{StrictEmptyJSON()}},
+			want:           false,
+			wantTestFailed: true,
+		},
+		{
+			name: "should pass when want and get nil pointers",
+			args: args{
+				got:  (*struct{ A int })(nil),
+				want: (*struct{ B int })(nil),
+				opts: []JSONOption{StrictEmptyJSON()},
+			},
+			want:           true,
+			wantTestFailed: false,
+		},
+		{
+			name:           "should fail when get nil reader",
+			args:           args{got: (*bytes.Buffer)(nil), want: `{}`},
+			want:           false,
+			wantTestFailed: true,
+		},
+		{
+			name:           "should pass when large numbers differ beyond float64 precision by default",
+			args:           args{got: `{"id": 9007199254740993}`, want: `{"id": 9007199254740992}`},
+			want:           true,
+			wantTestFailed: false,
+		},
+		{
+			name: "should fail when large numbers differ with exact numbers",
+			args: args{
+				got:  `{"id": 9007199254740993}`,
+				want: `{"id": 9007199254740992}`,
+				opts: []JSONOption{ExactJSONNumbers()},
+			},
+			want:           false,
+			wantTestFailed: true,
+			wantMessage:    []string{"/id: want 9007199254740992, got 9007199254740993"},
+		},
+		{
+			name: "should pass when numbers are equal decimals with exact numbers",
+			args: args{
+				got:  `{"price": 1.50, "qty": 1e2}`,
+				want: map[string]float64{"price": 1.5, "qty": 100},
+				opts: []JSONOption{ExactJSONNumbers()},
+			},
+			want:           true,
+			wantTestFailed: false,
+		},
+		{
+			name:           "should fail when array order differs by default",
+			args:           args{got: `{"tags": ["b", "a", "a"]}`, want: `{"tags": ["a", "a", "b"]}`},
+			want:           false,
+			wantTestFailed: true,
+			wantMessage:    []string{`/tags/0: want "a", got "b"`},
+		},
+		{
+			name: "should pass when array order differs at ignored path",
+			args: args{
+				got:  `{"tags": ["b", "a", "a"]}`,
+				want: `{"tags": ["a", "a", "b"]}`,
+				opts: []JSONOption{IgnoreJSONArrayOrder("/tags")},
+			},
+			want:           true,
+			wantTestFailed: false,
+		},
+		{
+			name: "should fail when multiplicities differ at ignored path",
+			args: args{
+				got:  `{"tags": ["b", "a", "b"]}`,
+				want: `{"tags": ["a", "a", "b"]}`,
+				opts: []JSONOption{IgnoreJSONArrayOrder("/tags")},
+			},
+			want:           false,
+			wantTestFailed: true,
+		},
+		{
+			name: "should report missing and unexpected elements of unordered array",
+			args: args{
+				got:  `{"tags": ["c", "a"]}`,
+				want: `{"tags": ["a", "b"]}`,
+				opts: []JSONOption{IgnoreJSONArrayOrder("/tags")},
+			},
+			want:           false,
+			wantTestFailed: true,
+			wantMessage:    []string{"/tags/1: missing, want \"b\"\n\t/tags/0: unexpected, got \"c\""},
+		},
+		{
+			name: "should pass when nested array order differs at wildcard path",
+			args: args{
+				got:  `{"orders": [{"items": [2, 1]}, {"items": [4, 3]}]}`,
+				want: `{"orders": [{"items": [1, 2]}, {"items": [3, 4]}]}`,
+				opts: []JSONOption{IgnoreJSONArrayOrder("/orders/*/items")},
+			},
+			want:           true,
+			wantTestFailed: false,
+		},
+		{
+			name:           "should fail when ignored path isn't a JSON Pointer",
+			args:           args{got: `[]`, want: `[]`, opts: []JSONOption{IgnoreJSONArrayOrder("tags")}},
+			want:           false,
+			wantTestFailed: true,
+			wantMessage:    []string{"Invalid argument"},
+		},
+		{
+			name:           "should pass when get duplicate keys by default",
+			args:           args{got: `{"a": 1, "a": 2}`, want: `{"a": 2}`},
+			want:           true,
+			wantTestFailed: false,
+		},
+		{
+			name: "should fail when get duplicate keys and they're rejected",
+			args: args{
+				got:  `{"b": [{"a": 1, "a": 2}]}`,
+				want: `{"b": [{"a": 2}]}`,
+				opts: []JSONOption{RejectDuplicateJSONKeys()},
+			},
+			want:           false,
+			wantTestFailed: true,
+			wantMessage:    []string{"Observed value must be JSON: duplicate JSON key at /b/0/a"},
+		},
+		{
+			name: "should pass when get no duplicate keys and they're rejected",
+			args: args{
+				got:  `{"a": {"a": 1}, "b": [{"a": 2}, {"a": 3}]}`,
+				want: `{"a": {"a": 1}, "b": [{"a": 2}, {"a": 3}]}`,
+				opts: []JSONOption{RejectDuplicateJSONKeys()},
+			},
+			want:           true,
+			wantTestFailed: false,
+		},
+		{
+			name:           "should fail when get data after top-level value",
+			args:           args{got: `{} {}`, want: `{}`},
+			want:           false,
+			wantTestFailed: true,
+			wantMessage:    []string{"Observed value must be JSON"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Given
+			assert := New(t)
+			dummyT := &fakeT{}
+			dummyAssert := New(dummyT)
+
+			// When
+			got := dummyAssert(tt.args.got).IsJSONEqualTo(tt.args.want, tt.args.opts...)
+
+			// Then
+			assert(got).Equals(tt.want)
+			assert(dummyT.failed).Equals(tt.wantTestFailed)
+			for _, want := range tt.wantMessage {
+				assert(strings.Contains(strings.Join(dummyT.logs, "\n"), want)).IsTrue()
+			}
+		})
+	}
+}
+
+func TestIsJSONMatching(t *testing.T) {
+	type args struct {
+		got  interface{}
+		want interface{}
+		opts []JSONOption
+	}
+	tests := []struct {
+		name           string
+		args           args
+		want           bool
+		wantTestFailed bool
+		wantMessage    []string
+	}{
+		{
+			name:           "should pass when observed has all specified keys",
+			args:           args{got: jsonTestObserved, want: `{"name": "alice", "address": {"city": "Oslo"}}`},
+			want:           true,
+			wantTestFailed: false,
+		},
+		{
+			name:           "should fail when specified key has other value",
+			args:           args{got: jsonTestObserved, want: `{"address": {"city": "Bergen"}}`},
+			want:           false,
+			wantTestFailed: true,
+			wantMessage:    []string{`/address/city: want "Bergen", got "Oslo"`},
+		},
+		{
+			name:           "should fail when specified key is missing",
+			args:           args{got: jsonTestObserved, want: `{"email": "<<ANY>>"}`},
+			want:           false,
+			wantTestFailed: true,
+			wantMessage:    []string{`/email: missing, want "<<ANY>>"`},
+		},
+		{
+			name: "should report every difference",
+			args: args{
+				got:  `{"id": "42", "name": "alice", "extra": true}`,
+				want: `{"id": "<<UUID>>", "name": "bob", "email": "<<ANY>>"}`,
+			},
+			want:           false,
+			wantTestFailed: true,
+			wantMessage: []string{
+				`/email: missing, want "<<ANY>>"`,
+				`/id: want "<<UUID>>", got "42"`,
+				`/name: want "bob", got "alice"`,
+			},
+		},
+		{
+			name: "should pass when placeholders match",
+			args: args{
+				got:  jsonTestObserved,
+				want: `{"id": "<<UUID>>", "createdAt": "<<RFC3339>>", "version": "<<ANY>>", "name": "<<REGEX:^al.*$>>"}`,
+			},
+			want:           true,
+			wantTestFailed: false,
+		},
+		{
+			name:           "should fail when string isn't a UUID",
+			args:           args{got: jsonTestObserved, want: `{"name": "<<UUID>>"}`},
+			want:           false,
+			wantTestFailed: true,
+		},
+		{
+			name:           "should fail when string isn't an RFC 3339 timestamp",
+			args:           args{got: jsonTestObserved, want: `{"name": "<<RFC3339>>"}`},
+			want:           false,
+			wantTestFailed: true,
+		},
+		{
+			name:           "should fail when regex placeholder is matched against number",
+			args:           args{got: jsonTestObserved, want: `{"version": "<<REGEX:3>>"}`},
+			want:           false,
+			wantTestFailed: true,
+		},
+		{
+			name:           "should fail when regex placeholder is invalid",
+			args:           args{got: jsonTestObserved, want: `{"name": "<<REGEX:(>>"}`},
+			want:           false,
+			wantTestFailed: true,
+			wantMessage:    []string{"Invalid argument"},
+		},
+		{
+			name: "should pass when array elements match",
+			args: args{
+				got:  `[{"sku": "A1", "qty": 3}, {"sku": "B2", "qty": 1}]`,
+				want: []map[string]interface{}{{"sku": "A1"}, {"qty": 1}},
+			},
+			want:           true,
+			wantTestFailed: false,
+		},
+		{
+			name:           "should fail when arrays have different lengths",
+			args:           args{got: jsonTestObserved, want: `{"tags": ["admin"]}`},
+			want:           false,
+			wantTestFailed: true,
+		},
+		{
+			name:           "should fail when want nil",
+			args:           args{got: jsonTestObserved, want: nil},
+			want:           false,
+			wantTestFailed: true,
+			wantMessage:    []string{"Invalid argument: expected value must not be nil"},
+		},
+		{
+			name:           "should fail when get empty reader with strict empty handling",
+			args:           args{got: strings.NewReader(""), want: `{}`, opts: []JSONOption{StrictEmptyJSON()}},
+			want:           false,
+			wantTestFailed: true,
+			wantMessage:    []string{"Observed value must be JSON: empty JSON input"},
+		},
+		{
+			name:           "should fail when want nil reader",
+			args:           args{got: `{}`, want: (*strings.Reader)(nil)},
+			want:           false,
+			wantTestFailed: true,
+		},
+		{
+			name: "should pass when marshalled integer equals exact number",
+			args: args{
+				got:  `[9007199254740993]`,
+				want: []int64{9007199254740993},
+				opts: []JSONOption{ExactJSONNumbers()},
+			},
+			want:           true,
+			wantTestFailed: false,
+		},
+		{
+			name: "should pass when placeholder must be paired with later element at ignored path",
+			args: args{
+				got:  `{"a": ["x", "y"]}`,
+				want: `{"a": ["<<ANY>>", "x"]}`,
+				opts: []JSONOption{IgnoreJSONArrayOrder("/a")},
+			},
+			want:           true,
+			wantTestFailed: false,
+		},
+		{
+			name: "should pass when subset object must be paired with later element at ignored path",
+			args: args{
+				got:  `[{"id": 1, "n": "x"}, {"id": 1, "n": "y"}]`,
+				want: `[{"id": 1}, {"id": 1, "n": "x"}]`,
+				opts: []JSONOption{IgnoreJSONArrayOrder("")},
+			},
+			want:           true,
+			wantTestFailed: false,
+		},
+		{
+			name: "should pass when partially matched elements are unordered",
+			args: args{
+				got:  `[{"sku": "B2", "qty": 1}, {"sku": "A1", "qty": 3}]`,
+				want: `[{"sku": "A1"}, {"sku": "B2"}]`,
+				opts: []JSONOption{IgnoreJSONArrayOrder("")},
+			},
+			want:           true,
+			wantTestFailed: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Given
+			assert := New(t)
+			dummyT := &fakeT{}
+			dummyAssert := New(dummyT)
+
+			// When
+			got := dummyAssert(tt.args.got).IsJSONMatching(tt.args.want, tt.args.opts...)
+
+			// Then
+			assert(got).Equals(tt.want)
+			assert(dummyT.failed).Equals(tt.wantTestFailed)
+			for _, want := range tt.wantMessage {
+				assert(strings.Contains(strings.Join(dummyT.logs, "\n"), want)).IsTrue()
+			}
+		})
+	}
+}
+
+func TestIsJSONMatchingDoesNotReportUnspecifiedKeys(t *testing.T) {
+	// Given
+	assert := New(t)
+	dummyT := &fakeT{}
+
+	// When
+	New(dummyT)(`{"id": "42", "extra": true}`).IsJSONMatching(`{"id": "<<UUID>>"}`)
+
+	// Then
+	assert(len(dummyT.logs)).Equals(1)
+	assert(strings.Contains(dummyT.logs[0], "/extra")).IsFalse()
+}
+
+func TestJSONDiff(t *testing.T) {
+	tests := []struct {
+		name string
+		want string
+		got  string
+		diff []string
+	}{
+		{
+			name: "should return no differences when documents are equal",
+			want: `{"a": [1, {"b": null}]}`,
+			got:  `{"a": [1, {"b": null}]}`,
+			diff: nil,
+		},
+		{
+			name: "should return pointer to differing array element field",
+			want: `{"items": [{"price": 1}, {"price": 10}]}`,
+			got:  `{"items": [{"price": 1}, {"price": 12}]}`,
+			diff: []string{"/items/1/price: want 10, got 12"},
+		},
+		{
+			name: "should return missing and unexpected keys",
+			want: `{"email": "a@b.c", "name": "alice"}`,
+			got:  `{"name": "alice", "nick": "al"}`,
+			diff: []string{`/email: missing, want "a@b.c"`, `/nick: unexpected, got "al"`},
+		},
+		{
+			name: "should return missing and unexpected array elements",
+			want: `{"a": [1, 2], "b": [1]}`,
+			got:  `{"a": [1], "b": [1, 3]}`,
+			diff: []string{"/a/1: missing, want 2", "/b/1: unexpected, got 3"},
+		},
+		{
+			name: "should return node when types differ",
+			want: `{"a": {"b": 1}}`,
+			got:  `{"a": [1]}`,
+			diff: []string{`/a: want {"b":1}, got [1]`},
+		},
+		{
+			name: "should return root when documents differ at root",
+			want: `true`,
+			got:  `"true"`,
+			diff: []string{`(root): want true, got "true"`},
+		},
+		{
+			name: "should escape keys in pointers",
+			want: `{"a/b": {"c~d": 1}}`,
+			got:  `{"a/b": {"c~d": 2}}`,
+			diff: []string{"/a~1b/c~0d: want 1, got 2"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Given
+			assert := New(t)
+			want, err := jsonTree(tt.want, jsonConfig{})
+			assert(err).IsNil()
+			got, err := jsonTree(tt.got, jsonConfig{})
+			assert(err).IsNil()
+			var d jsonDiffer
+
+			// When
+			d.walk("", want, got)
+
+			// Then
+			var diff []string
+			for _, d := range d.diffs {
+				diff = append(diff, d.String())
+			}
+			assert(diff).Equals(tt.diff)
+		})
+	}
+}
@@ -0,0 +1,748 @@
+package assert
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// JSONPath returns an asserter for the value(s) selected by the JSONPath expression 'path' from
+// the observed JSON document, which is accepted in the same forms, and decoded with the same
+// options, as in the IsJSONEqualTo method. If a single value is selected, the returned asserter
+// observes that value. If several values are selected, it observes them as a []interface{}, in
+// document order, where object members are ordered by key.
+//
+// The expression must start with $, the root of the document, followed by any of:
+//   - .name or ['name'] selecting an object member, and .* or [*] selecting all children
+//   - [i] selecting an array element, where negative indexes count from the end
+//   - [start:end:step] selecting a slice of an array, where all parts are optional
+//   - [a,b] selecting the union of several selectors
+//   - ..name, ..* or ..[selectors] applying the selectors to all descendants too
+//   - [?(filter)] selecting the children for which the filter holds. A filter compares relative
+//     paths starting with @, absolute paths starting with $ and literals using ==, !=, <, <=, >
+//     and >=, tests the existence of a path, and combines such tests using &&, || and !.
+//
+// The selected values are JSON trees, so expected values passed to the methods of the returned
+// asserter that compare them like in the Equals method, such as Equals, Contains, HasEntry and
+// Matches, are JSON-marshalled before they're compared. That way, Equals(3) holds for the JSON
+// number 3. The asserters passed to per-element callbacks, such as the one of the Each method,
+// compare expected values the same way. If the expression is invalid or nothing is selected, the
+// function under test is marked as having failed, and assertions made on the returned asserter
+// fail without being reported.
+//
+//	Example:
+//		assert(body).JSONPath("$.items[?(@.sku=='A1')].qty").Equals(3)
+func (a asserter) JSONPath(path string, opts ...JSONOption) asserter {
+	cfg, err := newJSONConfig(opts)
+	if err != nil {
+		return a.failNavigation(fmt.Sprintf("Invalid argument: %v", err), path, true, path)
+	}
+	query, err := parseJSONPath(path)
+	if err != nil {
+		return a.failNavigation(fmt.Sprintf("Invalid argument: %v", err), path, true, path)
+	}
+
+	root := a.got
+	if a.jsonCfg == nil {
+		if root, err = jsonTree(a.got, cfg); err != nil {
+			return a.failNavigation(fmt.Sprintf("Observed value must be JSON: %v", err), path, true, path)
+		}
+	}
+
+	var selected interface{}
+	switch nodes := query.evaluate(root, root); len(nodes) {
+	case 0:
+		return a.failNavigation(fmt.Sprintf("JSONPath %s must select at least one value", path), path, true, path)
+	case 1:
+		selected = nodes[0]
+	default:
+		selected = nodes
+	}
+	navigated := a.navigate(selected, path)
+	navigated.jsonCfg = &cfg
+	return navigated
+}
+
+// jsonWant converts 'want' to a JSON tree if the observed value was selected from a JSON
+// document, so that it can be compared with the observed value. Values that can't be converted
+// are returned unchanged. Numbers decoded as json.Number values are then compared as exact
+// decimals, unless the options hold another comparer for them.
+func (a asserter) jsonWant(want interface{}, opts []EqualOption) (interface{}, []EqualOption) {
+	if a.jsonCfg == nil {
+		return want, opts
+	}
+	opts = append([]EqualOption{WithComparer(numbersEqual)}, opts...)
+	data, err := json.Marshal(want)
+	if err != nil {
+		return want, opts
+	}
+	tree, err := jsonTree(data, *a.jsonCfg)
+	if err != nil {
+		return want, opts
+	}
+	return tree, opts
+}
+
+// jsonElement converts 'want' like the jsonWant method, for assertions without options, such as
+// Contains, and returns the configuration to compare it with.
+func (a asserter) jsonElement(want interface{}) (interface{}, equalConfig) {
+	want, opts := a.jsonWant(want, nil)
+	// The only option that can be added by jsonWant is a valid comparer
+	cfg, _ := newEqualConfig(opts)
+	return want, cfg
+}
+
+// jsonPathQuery is a parsed JSONPath expression.
+type jsonPathQuery struct {
+	// relative queries start at the current node, @, instead of the root node, $
+	relative bool
+	segments []jsonPathSegment
+}
+
+// evaluate returns the nodes selected by the query.
+func (q jsonPathQuery) evaluate(current, root interface{}) []interface{} {
+	nodes := []interface{}{root}
+	if q.relative {
+		nodes = []interface{}{current}
+	}
+	for _, segment := range q.segments {
+		var next []interface{}
+		for _, node := range nodes {
+			next = append(next, segment.apply(node, root)...)
+		}
+		nodes = next
+	}
+	return nodes
+}
+
+// jsonPathSegment selects nodes from each of the nodes selected by the preceding segments.
+type jsonPathSegment struct {
+	// recursive applies the selectors to every descendant of the nodes too, as in $..name
+	recursive bool
+	selectors []jsonPathSelector
+}
+
+func (s jsonPathSegment) apply(node, root interface{}) []interface{} {
+	nodes := []interface{}{node}
+	if s.recursive {
+		nodes = descendants(node, nodes)
+	}
+	var selected []interface{}
+	for _, n := range nodes {
+		for _, selector := range s.selectors {
+			selected = append(selected, selector.selectFrom(n, root)...)
+		}
+	}
+	return selected
+}
+
+// descendants appends the descendants of 'node' to 'nodes' in document order.
+func descendants(node interface{}, nodes []interface{}) []interface{} {
+	for _, child := range children(node) {
+		nodes = append(nodes, child)
+		nodes = descendants(child, nodes)
+	}
+	return nodes
+}
+
+// children returns the elements of an array, or the members of an object ordered by key.
+func children(node interface{}) []interface{} {
+	switch n := node.(type) {
+	case []interface{}:
+		return n
+	case map[string]interface{}:
+		keys := make([]string, 0, len(n))
+		for key := range n {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		values := make([]interface{}, 0, len(n))
+		for _, key := range keys {
+			values = append(values, n[key])
+		}
+		return values
+	}
+	return nil
+}
+
+// jsonPathSelector selects children of a node.
+type jsonPathSelector interface {
+	selectFrom(node, root interface{}) []interface{}
+}
+
+type nameSelector string
+
+func (s nameSelector) selectFrom(node, _ interface{}) []interface{} {
+	if object, ok := node.(map[string]interface{}); ok {
+		if value, found := object[string(s)]; found {
+			return []interface{}{value}
+		}
+	}
+	return nil
+}
+
+type wildcardSelector struct{}
+
+func (wildcardSelector) selectFrom(node, _ interface{}) []interface{} {
+	return children(node)
+}
+
+type indexSelector int
+
+func (s indexSelector) selectFrom(node, _ interface{}) []interface{} {
+	array, ok := node.([]interface{})
+	if !ok {
+		return nil
+	}
+	i := int(s)
+	if i < 0 {
+		i += len(array)
+	}
+	if i < 0 || i >= len(array) {
+		return nil
+	}
+	return []interface{}{array[i]}
+}
+
+type sliceSelector struct {
+	// start and end are nil if they were left out
+	start, end *int
+	step       int
+}
+
+func (s sliceSelector) selectFrom(node, _ interface{}) []interface{} {
+	array, ok := node.([]interface{})
+	if !ok || s.step == 0 {
+		return nil
+	}
+	length := len(array)
+	bound := func(i *int, defaultValue int) int {
+		if i == nil {
+			return defaultValue
+		}
+		if *i < 0 {
+			return *i + length
+		}
+		return *i
+	}
+
+	// The loops stop before stepping past the end, so that large steps can't overflow
+	var selected []interface{}
+	if s.step > 0 {
+		start, end := clamp(bound(s.start, 0), 0, length), clamp(bound(s.end, length), 0, length)
+		for i := start; i < end; i += s.step {
+			selected = append(selected, array[i])
+			if end-i <= s.step {
+				break
+			}
+		}
+		return selected
+	}
+	start, end := clamp(bound(s.start, length-1), -1, length-1), clamp(bound(s.end, -length-1), -1, length-1)
+	for i := start; i > end; i += s.step {
+		selected = append(selected, array[i])
+		if i-end <= -s.step {
+			break
+		}
+	}
+	return selected
+}
+
+func clamp(i, low, high int) int {
+	if i < low {
+		return low
+	}
+	if i > high {
+		return high
+	}
+	return i
+}
+
+type filterSelector struct {
+	filter jsonPathFilter
+}
+
+func (s filterSelector) selectFrom(node, root interface{}) []interface{} {
+	var selected []interface{}
+	for _, child := range children(node) {
+		if s.filter.holds(child, root) {
+			selected = append(selected, child)
+		}
+	}
+	return selected
+}
+
+// jsonPathFilter is a filter expression, evaluated for each child of a node.
+type jsonPathFilter interface {
+	holds(current, root interface{}) bool
+}
+
+type orFilter struct{ left, right jsonPathFilter }
+
+func (f orFilter) holds(current, root interface{}) bool {
+	return f.left.holds(current, root) || f.right.holds(current, root)
+}
+
+type andFilter struct{ left, right jsonPathFilter }
+
+func (f andFilter) holds(current, root interface{}) bool {
+	return f.left.holds(current, root) && f.right.holds(current, root)
+}
+
+type notFilter struct{ filter jsonPathFilter }
+
+func (f notFilter) holds(current, root interface{}) bool {
+	return !f.filter.holds(current, root)
+}
+
+// existenceFilter holds if the query selects at least one node.
+type existenceFilter struct{ query jsonPathQuery }
+
+func (f existenceFilter) holds(current, root interface{}) bool {
+	return len(f.query.evaluate(current, root)) > 0
+}
+
+type comparisonFilter struct {
+	left, right jsonPathOperand
+	operator    string
+}
+
+func (f comparisonFilter) holds(current, root interface{}) bool {
+	left, leftOK := f.left.value(current, root)
+	right, rightOK := f.right.value(current, root)
+	if !leftOK || !rightOK {
+		// Comparing a path that selects nothing only holds for inequality
+		return f.operator == "!=" && leftOK != rightOK
+	}
+
+	switch f.operator {
+	case "==":
+		return jsonValuesEqual(left, right)
+	case "!=":
+		return !jsonValuesEqual(left, right)
+	}
+	order, ok := compareJSONValues(left, right)
+	if !ok {
+		return false
+	}
+	switch f.operator {
+	case "<":
+		return order < 0
+	case "<=":
+		return order <= 0
+	case ">":
+		return order > 0
+	default:
+		return order >= 0
+	}
+}
+
+// jsonPathOperand is a literal or a query selecting a single node in a comparison.
+type jsonPathOperand struct {
+	// query is nil for literals
+	query   *jsonPathQuery
+	literal interface{}
+}
+
+// value returns the value of the operand. The second return value is false if the query doesn't
+// select exactly one node.
+func (o jsonPathOperand) value(current, root interface{}) (interface{}, bool) {
+	if o.query == nil {
+		return o.literal, true
+	}
+	nodes := o.query.evaluate(current, root)
+	if len(nodes) != 1 {
+		return nil, false
+	}
+	return nodes[0], true
+}
+
+// compareJSONNumbers orders two JSON numbers, decoded as float64 or json.Number values. Numbers
+// are compared exactly if both are json.Number values, which is the case for filter literals and
+// for documents decoded with exact numbers. Otherwise, they're compared as float64 values, so a
+// literal such as 0.1 equals the float64 it's decoded as. The second return value is false if
+// either value isn't a number.
+func compareJSONNumbers(a, b interface{}) (int, bool) {
+	aExact, aIsExact := a.(json.Number)
+	bExact, bIsExact := b.(json.Number)
+	if aIsExact && bIsExact {
+		aRat, aOK := new(big.Rat).SetString(aExact.String())
+		bRat, bOK := new(big.Rat).SetString(bExact.String())
+		if !aOK || !bOK {
+			return 0, false
+		}
+		return aRat.Cmp(bRat), true
+	}
+	aFloat, aOK := jsonFloat(a)
+	bFloat, bOK := jsonFloat(b)
+	if !aOK || !bOK {
+		return 0, false
+	}
+	return compareValues(aFloat, bFloat), true
+}
+
+// jsonFloat returns a JSON number, decoded as a float64 or json.Number value, as a float64.
+// Numbers beyond the range of float64 values are returned as infinities.
+func jsonFloat(v interface{}) (float64, bool) {
+	switch n := v.(type) {
+	case float64:
+		return n, true
+	case json.Number:
+		f, err := strconv.ParseFloat(n.String(), 64)
+		if err != nil && !errors.Is(err, strconv.ErrRange) {
+			return 0, false
+		}
+		return f, true
+	}
+	return 0, false
+}
+
+func jsonValuesEqual(a, b interface{}) bool {
+	if order, ok := compareJSONValues(a, b); ok {
+		return order == 0
+	}
+	return reflect.DeepEqual(a, b)
+}
+
+// compareJSONValues orders two numbers or two strings. The second return value is false if the
+// values can't be ordered.
+func compareJSONValues(a, b interface{}) (int, bool) {
+	if order, ok := compareJSONNumbers(a, b); ok {
+		return order, true
+	}
+	aString, aOK := a.(string)
+	bString, bOK := b.(string)
+	if aOK && bOK {
+		return strings.Compare(aString, bString), true
+	}
+	return 0, false
+}
+
+// jsonPathParser parses JSONPath expressions by recursive descent.
+type jsonPathParser struct {
+	input string
+	pos   int
+}
+
+func parseJSONPath(path string) (jsonPathQuery, error) {
+	p := &jsonPathParser{input: path}
+	p.skipSpaces()
+	if !p.consume("$") {
+		return jsonPathQuery{}, fmt.Errorf("JSONPath %q must start with $", path)
+	}
+	query, err := p.segments(false)
+	if err != nil {
+		return jsonPathQuery{}, fmt.Errorf("invalid JSONPath %q: %w", path, err)
+	}
+	p.skipSpaces()
+	if !p.done() {
+		return jsonPathQuery{}, fmt.Errorf("invalid JSONPath %q: %w", path, p.unexpected())
+	}
+	return query, nil
+}
+
+func (p *jsonPathParser) done() bool {
+	return p.pos >= len(p.input)
+}
+
+func (p *jsonPathParser) peek() byte {
+	if p.done() {
+		return 0
+	}
+	return p.input[p.pos]
+}
+
+// consume advances past 'token' if the remaining input starts with it.
+func (p *jsonPathParser) consume(token string) bool {
+	if strings.HasPrefix(p.input[p.pos:], token) {
+		p.pos += len(token)
+		return true
+	}
+	return false
+}
+
+func (p *jsonPathParser) skipSpaces() {
+	for !p.done() && unicode.IsSpace(rune(p.peek())) {
+		p.pos++
+	}
+}
+
+func (p *jsonPathParser) unexpected() error {
+	if p.done() {
+		return fmt.Errorf("unexpected end of expression")
+	}
+	r, _ := utf8.DecodeRuneInString(p.input[p.pos:])
+	return fmt.Errorf("unexpected character %q at offset %d", r, p.pos)
+}
+
+// segments parses the segments following $ or @.
+func (p *jsonPathParser) segments(relative bool) (jsonPathQuery, error) {
+	query := jsonPathQuery{relative: relative}
+	for {
+		var segment jsonPathSegment
+		switch {
+		case p.consume(".."):
+			segment.recursive = true
+			if p.peek() == '[' {
+				break
+			}
+			selector, err := p.dotSelector()
+			if err != nil {
+				return query, err
+			}
+			segment.selectors = []jsonPathSelector{selector}
+		case p.consume("."):
+			selector, err := p.dotSelector()
+			if err != nil {
+				return query, err
+			}
+			segment.selectors = []jsonPathSelector{selector}
+		case p.peek() == '[':
+		default:
+			return query, nil
+		}
+
+		if segment.selectors == nil {
+			selectors, err := p.bracketSelectors()
+			if err != nil {
+				return query, err
+			}
+			segment.selectors = selectors
+		}
+		query.segments = append(query.segments, segment)
+	}
+}
+
+// dotSelector parses the wildcard or member name following a dot.
+func (p *jsonPathParser) dotSelector() (jsonPathSelector, error) {
+	if p.consume("*") {
+		return wildcardSelector{}, nil
+	}
+	start := p.pos
+	for !p.done() {
+		r, size := utf8.DecodeRuneInString(p.input[p.pos:])
+		if r != '_' && !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			break
+		}
+		p.pos += size
+	}
+	if p.pos == start {
+		return nil, p.unexpected()
+	}
+	return nameSelector(p.input[start:p.pos]), nil
+}
+
+// bracketSelectors parses a comma-separated list of selectors in brackets.
+func (p *jsonPathParser) bracketSelectors() ([]jsonPathSelector, error) {
+	p.consume("[")
+	var selectors []jsonPathSelector
+	for {
+		p.skipSpaces()
+		selector, err := p.bracketSelector()
+		if err != nil {
+			return nil, err
+		}
+		selectors = append(selectors, selector)
+		p.skipSpaces()
+		if p.consume("]") {
+			return selectors, nil
+		}
+		if !p.consume(",") {
+			return nil, p.unexpected()
+		}
+	}
+}
+
+func (p *jsonPathParser) bracketSelector() (jsonPathSelector, error) {
+	switch c := p.peek(); {
+	case c == '*':
+		p.pos++
+		return wildcardSelector{}, nil
+	case c == '\'' || c == '"':
+		name, err := p.stringLiteral()
+		return nameSelector(name), err
+	case c == '?':
+		p.pos++
+		filter, err := p.orFilter()
+		return filterSelector{filter: filter}, err
+	default:
+		return p.indexOrSlice()
+	}
+}
+
+// indexOrSlice parses an index, e.g. -1, or a slice, e.g. 1:5:2.
+func (p *jsonPathParser) indexOrSlice() (jsonPathSelector, error) {
+	var bounds [3]*int
+	part := 0
+	for {
+		p.skipSpaces()
+		if c := p.peek(); c == '-' || c >= '0' && c <= '9' {
+			start := p.pos
+			p.pos++
+			for c := p.peek(); c >= '0' && c <= '9'; c = p.peek() {
+				p.pos++
+			}
+			i, err := strconv.Atoi(p.input[start:p.pos])
+			if err != nil {
+				return nil, fmt.Errorf("invalid index %q at offset %d", p.input[start:p.pos], start)
+			}
+			bounds[part] = &i
+		}
+		p.skipSpaces()
+		if part == 2 || !p.consume(":") {
+			break
+		}
+		part++
+	}
+
+	if part == 0 {
+		if bounds[0] == nil {
+			return nil, p.unexpected()
+		}
+		return indexSelector(*bounds[0]), nil
+	}
+	step := 1
+	if bounds[2] != nil {
+		step = *bounds[2]
+	}
+	return sliceSelector{start: bounds[0], end: bounds[1], step: step}, nil
+}
+
+// stringLiteral parses a single- or double-quoted string, in which a backslash escapes the
+// following character.
+func (p *jsonPathParser) stringLiteral() (string, error) {
+	quote := p.peek()
+	start := p.pos
+	p.pos++
+	var text strings.Builder
+	for !p.done() {
+		c := p.peek()
+		p.pos++
+		switch {
+		case c == quote:
+			return text.String(), nil
+		case c == '\\' && !p.done():
+			text.WriteByte(p.peek())
+			p.pos++
+		default:
+			text.WriteByte(c)
+		}
+	}
+	return "", fmt.Errorf("unterminated string starting at offset %d", start)
+}
+
+func (p *jsonPathParser) orFilter() (jsonPathFilter, error) {
+	left, err := p.andFilter()
+	if err != nil {
+		return nil, err
+	}
+	for p.skipSpaces(); p.consume("||"); p.skipSpaces() {
+		right, err := p.andFilter()
+		if err != nil {
+			return nil, err
+		}
+		left = orFilter{left: left, right: right}
+	}
+	return left, nil
+}
+
+func (p *jsonPathParser) andFilter() (jsonPathFilter, error) {
+	left, err := p.unaryFilter()
+	if err != nil {
+		return nil, err
+	}
+	for p.skipSpaces(); p.consume("&&"); p.skipSpaces() {
+		right, err := p.unaryFilter()
+		if err != nil {
+			return nil, err
+		}
+		left = andFilter{left: left, right: right}
+	}
+	return left, nil
+}
+
+func (p *jsonPathParser) unaryFilter() (jsonPathFilter, error) {
+	p.skipSpaces()
+	switch {
+	case p.consume("!"):
+		filter, err := p.unaryFilter()
+		return notFilter{filter: filter}, err
+	case p.consume("("):
+		filter, err := p.orFilter()
+		if err != nil {
+			return nil, err
+		}
+		p.skipSpaces()
+		if !p.consume(")") {
+			return nil, p.unexpected()
+		}
+		return filter, nil
+	}
+	return p.comparisonFilter()
+}
+
+// jsonPathOperators are the comparison operators, ordered so that no operator is preceded by one
+// of its prefixes.
+var jsonPathOperators = []string{"==", "!=", "<=", ">=", "<", ">"}
+
+func (p *jsonPathParser) comparisonFilter() (jsonPathFilter, error) {
+	left, err := p.operand()
+	if err != nil {
+		return nil, err
+	}
+	p.skipSpaces()
+	for _, operator := range jsonPathOperators {
+		if p.consume(operator) {
+			p.skipSpaces()
+			right, err := p.operand()
+			if err != nil {
+				return nil, err
+			}
+			return comparisonFilter{left: left, right: right, operator: operator}, nil
+		}
+	}
+	if left.query == nil {
+		return nil, fmt.Errorf("literal at offset %d must be compared", p.pos)
+	}
+	return existenceFilter{query: *left.query}, nil
+}
+
+func (p *jsonPathParser) operand() (jsonPathOperand, error) {
+	switch c := p.peek(); {
+	case c == '@' || c == '$':
+		p.pos++
+		query, err := p.segments(c == '@')
+		return jsonPathOperand{query: &query}, err
+	case c == '\'' || c == '"':
+		text, err := p.stringLiteral()
+		return jsonPathOperand{literal: text}, err
+	case c == '-' || c >= '0' && c <= '9':
+		start := p.pos
+		p.pos++
+		for c := p.peek(); c >= '0' && c <= '9' || c == '.' || c == 'e' || c == 'E' || c == '+' || c == '-'; c = p.peek() {
+			p.pos++
+		}
+		number := json.Number(p.input[start:p.pos])
+		if _, ok := new(big.Rat).SetString(number.String()); !ok {
+			return jsonPathOperand{}, fmt.Errorf("invalid number %q at offset %d", number, start)
+		}
+		return jsonPathOperand{literal: number}, nil
+	}
+
+	for _, literal := range []struct {
+		text  string
+		value interface{}
+	}{{"true", true}, {"false", false}, {"null", nil}} {
+		if p.consume(literal.text) {
+			return jsonPathOperand{literal: literal.value}, nil
+		}
+	}
+	return jsonPathOperand{}, p.unexpected()
+}
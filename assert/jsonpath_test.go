@@ -0,0 +1,594 @@
+package assert
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+const storeJSON = `{
+	"store": {
+		"items": [
+			{"sku": "A1", "qty": 3, "price": 10.5, "tags": ["new"]},
+			{"sku": "B2", "qty": 0, "price": 4},
+			{"sku": "C3", "qty": 7, "price": 12, "discount": {"price": 9}}
+		],
+		"owner": {"name": "alice"}
+	}
+}`
+
+func TestJSONPath(t *testing.T) {
+	type args struct {
+		got  interface{}
+		path string
+		opts []JSONOption
+		want interface{}
+	}
+	tests := []struct {
+		name           string
+		args           args
+		want           bool
+		wantTestFailed bool
+		wantMessage    []string
+	}{
+		{
+			name:           "should pass when member selected by dot notation equals want",
+			args:           args{got: storeJSON, path: "$.store.owner.name", want: "alice"},
+			want:           true,
+			wantTestFailed: false,
+		},
+		{
+			name:           "should pass when member selected by bracket notation equals want",
+			args:           args{got: storeJSON, path: `$['store']["owner"]`, want: map[string]string{"name": "alice"}},
+			want:           true,
+			wantTestFailed: false,
+		},
+		{
+			name:           "should pass when selected number equals integer",
+			args:           args{got: storeJSON, path: "$.store.items[0].qty", want: 3},
+			want:           true,
+			wantTestFailed: false,
+		},
+		{
+			name:           "should fail when selected number differs",
+			args:           args{got: storeJSON, path: "$.store.items[0].qty", want: 4},
+			want:           false,
+			wantTestFailed: true,
+			wantMessage:    []string{"$.store.items[0].qty: Observed and expected values must be equal"},
+		},
+		{
+			name:           "should fail when selected string differs",
+			args:           args{got: storeJSON, path: "$.store.owner.name", want: "bob"},
+			want:           false,
+			wantTestFailed: true,
+			wantMessage:    []string{"$.store.owner.name: Observed and expected values must be equal"},
+		},
+		{
+			name:           "should pass when element selected by negative index equals want",
+			args:           args{got: storeJSON, path: "$.store.items[-1].sku", want: "C3"},
+			want:           true,
+			wantTestFailed: false,
+		},
+		{
+			name:           "should pass when value selected with filter equals want",
+			args:           args{got: storeJSON, path: "$.store.items[?(@.sku=='A1')].qty", want: 3},
+			want:           true,
+			wantTestFailed: false,
+		},
+		{
+			name: "should pass when values selected with combined filter equal want",
+			args: args{
+				got:  storeJSON,
+				path: `$.store.items[?(@.qty > 0 && !(@.price >= 12) || @.sku == "B2")].sku`,
+				want: []string{"A1", "B2"},
+			},
+			want:           true,
+			wantTestFailed: false,
+		},
+		{
+			name:           "should pass when value selected with existence filter equals want",
+			args:           args{got: storeJSON, path: "$.store.items[?(@.discount)].sku", want: "C3"},
+			want:           true,
+			wantTestFailed: false,
+		},
+		{
+			name: "should pass when values selected with filter comparing to absolute path equal want",
+			args: args{
+				got:  `{"limit": 5, "values": [3, 6, 9]}`,
+				path: "$.values[?(@ > $.limit)]",
+				want: []int{6, 9},
+			},
+			want:           true,
+			wantTestFailed: false,
+		},
+		{
+			name: "should pass when value selected with filter comparing to non-integer literal equals want",
+			args: args{
+				got:  `{"items": [{"sku": "A1", "p": 0.1}, {"sku": "B2", "p": 0.3}]}`,
+				path: "$.items[?(@.p==0.1)].sku",
+				want: "A1",
+			},
+			want:           true,
+			wantTestFailed: false,
+		},
+		{
+			name:           "should exclude value equal to non-integer literal with strict filter",
+			args:           args{got: `[0.1, 0.3]`, path: "$[?(@ > 0.1)]", want: 0.3},
+			want:           true,
+			wantTestFailed: false,
+		},
+		{
+			name: "should pass when exact number selected with filter comparing to non-integer literal equals want",
+			args: args{
+				got:  `[0.1, 0.30000000000000001]`,
+				path: "$[?(@ >= 0.3)]",
+				opts: []JSONOption{ExactJSONNumbers()},
+				want: json.Number("0.30000000000000001"),
+			},
+			want:           true,
+			wantTestFailed: false,
+		},
+		{
+			name:           "should pass when children selected with wildcard equal want",
+			args:           args{got: storeJSON, path: "$.store.items[*].sku", want: []string{"A1", "B2", "C3"}},
+			want:           true,
+			wantTestFailed: false,
+		},
+		{
+			name:           "should pass when descendants selected with recursive descent equal want",
+			args:           args{got: storeJSON, path: "$..price", want: []float64{10.5, 4, 12, 9}},
+			want:           true,
+			wantTestFailed: false,
+		},
+		{
+			name:           "should pass when slice equals want",
+			args:           args{got: `[0, 1, 2, 3, 4, 5]`, path: "$[1:5:2]", want: []int{1, 3}},
+			want:           true,
+			wantTestFailed: false,
+		},
+		{
+			name:           "should pass when slice with negative step equals want",
+			args:           args{got: `[0, 1, 2, 3]`, path: "$[::-1]", want: []int{3, 2, 1, 0}},
+			want:           true,
+			wantTestFailed: false,
+		},
+		{
+			name:           "should pass when slice with step larger than array equals want",
+			args:           args{got: `{"a": [0, 1, 2, 3]}`, path: "$.a[1:3:9223372036854775807]", want: 1},
+			want:           true,
+			wantTestFailed: false,
+		},
+		{
+			name:           "should pass when slice with negative step larger than array equals want",
+			args:           args{got: `{"a": [0, 1, 2, 3]}`, path: "$.a[::-9223372036854775808]", want: 3},
+			want:           true,
+			wantTestFailed: false,
+		},
+		{
+			name:           "should pass when exact number equals integer as decimal",
+			args:           args{got: `{"a": 3.0}`, path: "$.a", opts: []JSONOption{ExactJSONNumbers()}, want: 3},
+			want:           true,
+			wantTestFailed: false,
+		},
+		{
+			name: "should fail when exact numbers differ beyond float64 precision",
+			args: args{
+				got:  `{"a": 9007199254740993}`,
+				path: "$.a",
+				opts: []JSONOption{ExactJSONNumbers()},
+				want: int64(9007199254740992),
+			},
+			want:           false,
+			wantTestFailed: true,
+		},
+		{
+			name: "should pass when union equals want",
+			args: args{
+				got:  storeJSON,
+				path: "$.store.items[0,2]['sku','qty']",
+				want: []interface{}{"A1", 3, "C3", 7},
+			},
+			want:           true,
+			wantTestFailed: false,
+		},
+		{
+			name:           "should pass when value selected from marshalled value equals want",
+			args:           args{got: map[string][]int{"values": {1, 2}}, path: "$.values[1]", want: 2},
+			want:           true,
+			wantTestFailed: false,
+		},
+		{
+			name:           "should fail when nothing is selected",
+			args:           args{got: storeJSON, path: "$.store.items[?(@.sku=='Z9')]", want: nil},
+			want:           false,
+			wantTestFailed: true,
+			wantMessage:    []string{"JSONPath $.store.items[?(@.sku=='Z9')] must select at least one value"},
+		},
+		{
+			name:           "should fail when member doesn't exist",
+			args:           args{got: storeJSON, path: "$.store.missing", want: nil},
+			want:           false,
+			wantTestFailed: true,
+			wantMessage:    []string{"JSONPath $.store.missing must select at least one value"},
+		},
+		{
+			name:           "should fail when path is invalid",
+			args:           args{got: storeJSON, path: "store.items", want: nil},
+			want:           false,
+			wantTestFailed: true,
+			wantMessage:    []string{"Invalid argument", "must start with $"},
+		},
+		{
+			name:           "should fail when observed value isn't JSON",
+			args:           args{got: `{"store": `, path: "$.store", want: nil},
+			want:           false,
+			wantTestFailed: true,
+			wantMessage:    []string{"Observed value must be JSON"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Given
+			assert := New(t)
+			dummyT := &fakeT{}
+			dummyAssert := New(dummyT)
+
+			// When
+			got := dummyAssert(tt.args.got).JSONPath(tt.args.path, tt.args.opts...).Equals(tt.args.want)
+
+			// Then
+			assert(got).Equals(tt.want)
+			assert(dummyT.failed).Equals(tt.wantTestFailed)
+			for _, want := range tt.wantMessage {
+				assert(strings.Contains(strings.Join(dummyT.logs, "\n"), want)).IsTrue()
+			}
+		})
+	}
+}
+
+func TestJSONPathNotEquals(t *testing.T) {
+	type args struct {
+		got  interface{}
+		path string
+		opts []JSONOption
+		want interface{}
+	}
+	tests := []struct {
+		name           string
+		args           args
+		want           bool
+		wantTestFailed bool
+		wantMessage    []string
+	}{
+		{
+			name:           "should pass when selected number doesn't equal integer",
+			args:           args{got: storeJSON, path: "$.store.items[0].qty", want: 4},
+			want:           true,
+			wantTestFailed: false,
+		},
+		{
+			name: "should fail when nested exact numbers equal want",
+			args: args{
+				got:  `{"a": [1.50, 2]}`,
+				path: "$.a",
+				opts: []JSONOption{ExactJSONNumbers()},
+				want: []float64{1.5, 2},
+			},
+			want:           false,
+			wantTestFailed: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Given
+			assert := New(t)
+			dummyT := &fakeT{}
+			dummyAssert := New(dummyT)
+
+			// When
+			got := dummyAssert(tt.args.got).JSONPath(tt.args.path, tt.args.opts...).NotEquals(tt.args.want)
+
+			// Then
+			assert(got).Equals(tt.want)
+			assert(dummyT.failed).Equals(tt.wantTestFailed)
+			for _, want := range tt.wantMessage {
+				assert(strings.Contains(strings.Join(dummyT.logs, "\n"), want)).IsTrue()
+			}
+		})
+	}
+}
+
+func TestJSONPathContains(t *testing.T) {
+	type args struct {
+		got  interface{}
+		path string
+		want interface{}
+	}
+	tests := []struct {
+		name           string
+		args           args
+		want           bool
+		wantTestFailed bool
+		wantMessage    []string
+	}{
+		{
+			name:           "should pass when integer is in selected array",
+			args:           args{got: `{"n": [1, 2]}`, path: "$.n", want: 2},
+			want:           true,
+			wantTestFailed: false,
+		},
+		{
+			name:           "should fail when integer isn't in selected array",
+			args:           args{got: `{"n": [1, 2]}`, path: "$.n", want: 3},
+			want:           false,
+			wantTestFailed: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Given
+			assert := New(t)
+			dummyT := &fakeT{}
+			dummyAssert := New(dummyT)
+
+			// When
+			got := dummyAssert(tt.args.got).JSONPath(tt.args.path).Contains(tt.args.want)
+
+			// Then
+			assert(got).Equals(tt.want)
+			assert(dummyT.failed).Equals(tt.wantTestFailed)
+			for _, want := range tt.wantMessage {
+				assert(strings.Contains(strings.Join(dummyT.logs, "\n"), want)).IsTrue()
+			}
+		})
+	}
+}
+
+func TestJSONPathMatches(t *testing.T) {
+	type addr struct {
+		City string `json:"city"`
+		L    []int
+	}
+	type user struct {
+		Name string            `json:"name"`
+		Addr *addr             `json:"addr"`
+		Tags []string          `json:"tags"`
+		Meta map[string]string `json:"meta"`
+	}
+
+	type args struct {
+		got  interface{}
+		path string
+		want interface{}
+	}
+	tests := []struct {
+		name           string
+		args           args
+		want           bool
+		wantTestFailed bool
+		wantMessage    []string
+	}{
+		{
+			name: "should pass when selected object matches struct",
+			args: args{
+				got:  `{"m": {"a": 1, "b": 2}}`,
+				path: "$.m",
+				want: struct {
+					A int `json:"a"`
+				}{A: 1},
+			},
+			want:           true,
+			wantTestFailed: false,
+		},
+		{
+			name: "should pass when selected document matches struct having nil fields",
+			args: args{
+				got:  `{"name": "alice", "addr": {"city": "Oslo", "zip": "0150"}, "tags": ["a"]}`,
+				path: "$",
+				want: user{Addr: &addr{City: "Oslo"}},
+			},
+			want:           true,
+			wantTestFailed: false,
+		},
+		{
+			name:           "should fail when selected object doesn't match struct",
+			args:           args{got: `{"addr": {"city": "Bergen"}}`, path: "$", want: user{Addr: &addr{City: "Oslo"}}},
+			want:           false,
+			wantTestFailed: true,
+			wantMessage:    []string{`["addr"]["city"]: want "Oslo", got "Bergen"`},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Given
+			assert := New(t)
+			dummyT := &fakeT{}
+			dummyAssert := New(dummyT)
+
+			// When
+			got := dummyAssert(tt.args.got).JSONPath(tt.args.path).Matches(tt.args.want)
+
+			// Then
+			assert(got).Equals(tt.want)
+			assert(dummyT.failed).Equals(tt.wantTestFailed)
+			for _, want := range tt.wantMessage {
+				assert(strings.Contains(strings.Join(dummyT.logs, "\n"), want)).IsTrue()
+			}
+		})
+	}
+}
+
+func TestJSONPathIsJSONEqualTo(t *testing.T) {
+	type args struct {
+		got  interface{}
+		path string
+		want interface{}
+	}
+	tests := []struct {
+		name           string
+		args           args
+		want           bool
+		wantTestFailed bool
+		wantMessage    []string
+	}{
+		{
+			name:           "should pass when selected string equals JSON",
+			args:           args{got: `{"name": "alice"}`, path: "$.name", want: `"alice"`},
+			want:           true,
+			wantTestFailed: false,
+		},
+		{
+			name:           "should pass when selected null equals JSON",
+			args:           args{got: `{"name": null}`, path: "$.name", want: `null`},
+			want:           true,
+			wantTestFailed: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Given
+			assert := New(t)
+			dummyT := &fakeT{}
+			dummyAssert := New(dummyT)
+
+			// When
+			got := dummyAssert(tt.args.got).JSONPath(tt.args.path).IsJSONEqualTo(tt.args.want)
+
+			// Then
+			assert(got).Equals(tt.want)
+			assert(dummyT.failed).Equals(tt.wantTestFailed)
+			for _, want := range tt.wantMessage {
+				assert(strings.Contains(strings.Join(dummyT.logs, "\n"), want)).IsTrue()
+			}
+		})
+	}
+}
+
+func TestJSONPathChained(t *testing.T) {
+	// Given
+	assert := New(t)
+	dummyT := &fakeT{}
+	dummyAssert := New(dummyT)
+
+	// When
+	got := dummyAssert(storeJSON).JSONPath("$.store").JSONPath("$.owner.name").Equals("alice")
+
+	// Then
+	assert(got).IsTrue()
+	assert(dummyT.failed).IsFalse()
+}
+
+func TestJSONPathNavigatesIntoSelectedValue(t *testing.T) {
+	// Given
+	assert := New(t)
+	dummyT := &fakeT{}
+	dummyAssert := New(dummyT)
+
+	// When
+	got := dummyAssert(storeJSON).JSONPath("$.store.items[2]").Field("discount.price").Equals(9)
+
+	// Then
+	assert(got).IsTrue()
+	assert(dummyT.failed).IsFalse()
+}
+
+func TestJSONPathEachComparesSelectedElementsWithInteger(t *testing.T) {
+	// Given
+	assert := New(t)
+	dummyT := &fakeT{}
+	dummyAssert := New(dummyT)
+
+	// When
+	got := dummyAssert(`{"items": [{"qty": 3}, {"qty": 3}]}`).JSONPath("$.items[*].qty").Each(func(qty Asserter) {
+		qty.Equals(3)
+	})
+
+	// Then
+	assert(got).IsTrue()
+	assert(dummyT.failed).IsFalse()
+}
+
+func TestJSONPathContainsAll(t *testing.T) {
+	// Given
+	assert := New(t)
+	dummyT := &fakeT{}
+	dummyAssert := New(dummyT)
+
+	// When
+	got := dummyAssert(`{"n": [1, 2]}`).JSONPath("$.n").ContainsAll(1, 2)
+
+	// Then
+	assert(got).IsTrue()
+	assert(dummyT.failed).IsFalse()
+}
+
+func TestJSONPathHasEntry(t *testing.T) {
+	// Given
+	assert := New(t)
+	dummyT := &fakeT{}
+	dummyAssert := New(dummyT)
+
+	// When
+	got := dummyAssert(`{"m": {"a": 1}}`).JSONPath("$.m").HasEntry("a", 1)
+
+	// Then
+	assert(got).IsTrue()
+	assert(dummyT.failed).IsFalse()
+}
+
+func TestJSONPathGreaterThanWithExactNumber(t *testing.T) {
+	// Given
+	assert := New(t)
+	dummyT := &fakeT{}
+	dummyAssert := New(dummyT)
+
+	// When
+	got := dummyAssert(`{"n": 4}`).JSONPath("$.n", ExactJSONNumbers()).GreaterThan(3)
+
+	// Then
+	assert(got).IsTrue()
+	assert(dummyT.failed).IsFalse()
+}
+
+func TestJSONPathInDeltaWithExactNumber(t *testing.T) {
+	// Given
+	assert := New(t)
+	dummyT := &fakeT{}
+	dummyAssert := New(dummyT)
+
+	// When
+	got := dummyAssert(`{"n": 0.30000000000000004}`).JSONPath("$.n", ExactJSONNumbers()).InDelta(0.3, 1e-9)
+
+	// Then
+	assert(got).IsTrue()
+	assert(dummyT.failed).IsFalse()
+}
+
+func TestParseJSONPathErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		path string
+		want string
+	}{
+		{name: "should reject path without root", path: "store", want: "must start with $"},
+		{name: "should reject trailing characters", path: "$.a b", want: `unexpected character 'b' at offset 4`},
+		{name: "should reject unterminated bracket", path: "$[0", want: "unexpected end of expression"},
+		{name: "should reject unterminated string", path: "$['a]", want: "unterminated string starting at offset 2"},
+		{name: "should reject missing name", path: "$.", want: "unexpected end of expression"},
+		{name: "should reject uncompared literal", path: "$[?(1)]", want: "literal at offset 5 must be compared"},
+		{name: "should reject unbalanced parenthesis", path: "$[?(@.a]", want: `unexpected character ']' at offset 7`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Given
+			assert := New(t)
+
+			// When
+			_, err := parseJSONPath(tt.path)
+
+			// Then
+			assert(err).IsNotNil()
+			assert(strings.Contains(err.Error(), tt.want)).IsTrue()
+		})
+	}
+}
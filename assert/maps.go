@@ -0,0 +1,172 @@
+package assert
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// observedMap returns the observed value as a map. If it's not a map, the function under test is
+// marked as having failed.
+func (a asserter) observedMap(want interface{}) (reflect.Value, bool) {
+	if a.got == nil || reflect.TypeOf(a.got).Kind() != reflect.Map {
+		a.errorf("Observed value must be a map", want, true)
+		return reflect.Value{}, false
+	}
+	return reflect.ValueOf(a.got), true
+}
+
+// lookup returns the value of the map key equal to 'key', where keys are compared like in the
+// Equals method. The second return value is false if there's no such key.
+func lookup(m reflect.Value, key interface{}) (reflect.Value, bool) {
+	if key != nil && reflect.TypeOf(key) == m.Type().Key() {
+		if elem := m.MapIndex(reflect.ValueOf(key)); elem.IsValid() {
+			return elem, true
+		}
+	}
+	iter := m.MapRange()
+	for iter.Next() {
+		if equals(iter.Key().Interface(), key) {
+			return iter.Value(), true
+		}
+	}
+	return reflect.Value{}, false
+}
+
+// HasKey asserts the observed value is a map with the key 'want'. If not, the function under test
+// is marked as having failed.
+func (a asserter) HasKey(want interface{}) bool {
+	if a.navigationFailed {
+		return false
+	}
+	m, ok := a.observedMap(want)
+	if !ok {
+		return false
+	}
+	if _, found := lookup(m, want); !found {
+		a.errorf("Observed map must have expected key", want, true,
+			fmt.Sprintf("Keys: %s", formatElements(mapKeys(m))))
+		return false
+	}
+	return true
+}
+
+// HasEntry asserts the observed value is a map with the key 'key', whose value equals 'want'.
+// Values are compared like in the Equals method, and the comparison can be adjusted with the same
+// options. If not, the function under test is marked as having failed.
+//
+//	Example:
+//		assert(headers).HasEntry("Content-Type", []string{"application/json"})
+func (a asserter) HasEntry(key, want interface{}, opts ...EqualOption) bool {
+	if a.navigationFailed {
+		return false
+	}
+	want, opts = a.jsonWant(want, opts)
+	cfg, err := newEqualConfig(opts)
+	if err != nil {
+		a.errorf(fmt.Sprintf("Invalid argument: %v", err), want, true)
+		return false
+	}
+	m, ok := a.observedMap(want)
+	if !ok {
+		return false
+	}
+	details := entryDifferences(m, key, want, cfg)
+	if len(details) > 0 {
+		a.errorf(fmt.Sprintf("Observed map must have expected entry for key %s", formatValue(reflect.ValueOf(key))),
+			want, true, details...)
+		return false
+	}
+	return true
+}
+
+// ContainsSubMap asserts the observed value is a map with all the entries in the 'want' map.
+// Entries in the observed map, whose keys aren't in the 'want' map, are ignored. Values are
+// compared like in the Equals method, and the comparison can be adjusted with the same options.
+// If any entry is missing or has another value, the function under test is marked as having
+// failed.
+func (a asserter) ContainsSubMap(want interface{}, opts ...EqualOption) bool {
+	if a.navigationFailed {
+		return false
+	}
+	want, opts = a.jsonWant(want, opts)
+	cfg, err := newEqualConfig(opts)
+	if err == nil && (want == nil || reflect.TypeOf(want).Kind() != reflect.Map) {
+		err = fmt.Errorf("expected value must be a map, got %T", want)
+	}
+	if err != nil {
+		a.errorf(fmt.Sprintf("Invalid argument: %v", err), want, true)
+		return false
+	}
+	m, ok := a.observedMap(want)
+	if !ok {
+		return false
+	}
+
+	var details []string
+	wantMap := reflect.ValueOf(want)
+	for _, key := range sortedKeys(wantMap) {
+		details = append(details, entryDifferences(m, key.Interface(), wantMap.MapIndex(key).Interface(), cfg)...)
+	}
+	if len(details) > 0 {
+		a.errorf("Observed map must contain expected entries", want, true, details...)
+		return false
+	}
+	return true
+}
+
+// entryDifferences returns the differences between the value of the key in the map and 'want',
+// labeled with the key.
+func entryDifferences(m reflect.Value, key, want interface{}, cfg equalConfig) []string {
+	keyPath := valuePath{}.key(reflect.ValueOf(key))
+	got, found := lookup(m, key)
+	if !found {
+		return []string{difference{path: keyPath.display, want: formatValue(reflect.ValueOf(want)), missing: true}.String()}
+	}
+
+	diffs := diff(want, got.Interface(), cfg)
+	details := make([]string, 0, len(diffs))
+	for _, d := range diffs {
+		d.path = keyPath.display + d.path
+		details = append(details, d.String())
+	}
+	return details
+}
+
+// IgnoringOrderEqualsKeysIn asserts the observed value is a map whose keys are the elements of
+// the 'want' argument, ignoring order. The 'want' argument must be a slice or array. If there are
+// missing or unexpected keys, the function under test is marked as having failed.
+//
+//	Example:
+//		assert(got).IgnoringOrderEqualsKeysIn([]string{"id", "name"})
+func (a asserter) IgnoringOrderEqualsKeysIn(want interface{}) bool {
+	if a.navigationFailed {
+		return false
+	}
+	if !isList(want) {
+		a.errorf("Invalid argument: expected value must be a slice or array", want, true)
+		return false
+	}
+	m, ok := a.observedMap(want)
+	if !ok {
+		return false
+	}
+
+	missing, unexpected := multisetDifference(reflect.ValueOf(want), reflect.ValueOf(mapKeys(m)), equalConfig{})
+	if len(missing) > 0 || len(unexpected) > 0 {
+		a.errorf("Observed map keys and expected keys must be equal, ignoring order", want, true,
+			fmt.Sprintf("Missing keys: %s", formatElements(missing)),
+			fmt.Sprintf("Unexpected keys: %s", formatElements(unexpected)),
+		)
+		return false
+	}
+	return true
+}
+
+// mapKeys returns the keys of the map, sorted like in failure messages.
+func mapKeys(m reflect.Value) []interface{} {
+	keys := make([]interface{}, 0, m.Len())
+	for _, key := range sortedKeys(m) {
+		keys = append(keys, key.Interface())
+	}
+	return keys
+}
@@ -0,0 +1,272 @@
+package assert
+
+import (
+	"strings"
+	"testing"
+)
+
+type mapsTestAddress struct {
+	City string
+	Zip  string
+}
+
+var mapsTestUsers = map[string]mapsTestAddress{
+	"alice": {City: "Oslo", Zip: "0150"},
+	"bob":   {City: "Bergen", Zip: "5003"},
+}
+
+func TestHasKey(t *testing.T) {
+	type args struct {
+		got  interface{}
+		want interface{}
+	}
+	tests := []struct {
+		name           string
+		args           args
+		want           bool
+		wantTestFailed bool
+		wantMessage    []string
+	}{
+		{
+			name:           "should pass when map has key",
+			args:           args{got: mapsTestUsers, want: "alice"},
+			want:           true,
+			wantTestFailed: false,
+		},
+		{
+			name:           "should fail when map doesn't have key",
+			args:           args{got: mapsTestUsers, want: "carol"},
+			want:           false,
+			wantTestFailed: true,
+			wantMessage:    []string{`Keys: ["alice", "bob"]`},
+		},
+		{
+			name:           "should fail when key has other type",
+			args:           args{got: map[int64]string{1: "a"}, want: 1},
+			want:           false,
+			wantTestFailed: true,
+		},
+		{
+			name:           "should pass when interface keyed map has key",
+			args:           args{got: map[interface{}]string{1: "a", "b": "c"}, want: "b"},
+			want:           true,
+			wantTestFailed: false,
+		},
+		{
+			name:           "should fail when want key but get non-map",
+			args:           args{got: []string{"alice"}, want: "alice"},
+			want:           false,
+			wantTestFailed: true,
+			wantMessage:    []string{"Observed value must be a map"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Given
+			assert := New(t)
+			dummyT := &fakeT{}
+			dummyAssert := New(dummyT)
+
+			// When
+			got := dummyAssert(tt.args.got).HasKey(tt.args.want)
+
+			// Then
+			assert(got).Equals(tt.want)
+			assert(dummyT.failed).Equals(tt.wantTestFailed)
+			for _, want := range tt.wantMessage {
+				assert(strings.Contains(strings.Join(dummyT.logs, "\n"), want)).IsTrue()
+			}
+		})
+	}
+}
+
+func TestHasEntry(t *testing.T) {
+	type args struct {
+		got  interface{}
+		key  interface{}
+		want interface{}
+		opts []EqualOption
+	}
+	tests := []struct {
+		name           string
+		args           args
+		want           bool
+		wantTestFailed bool
+		wantMessage    []string
+	}{
+		{
+			name:           "should pass when map has entry",
+			args:           args{got: mapsTestUsers, key: "bob", want: mapsTestAddress{City: "Bergen", Zip: "5003"}},
+			want:           true,
+			wantTestFailed: false,
+		},
+		{
+			name:           "should fail when map entry has other value",
+			args:           args{got: mapsTestUsers, key: "bob", want: mapsTestAddress{City: "Oslo", Zip: "5003"}},
+			want:           false,
+			wantTestFailed: true,
+			wantMessage:    []string{`Observed map must have expected entry for key "bob"`, `.City: want "Oslo", got "Bergen"`},
+		},
+		{
+			name: "should pass when map entry equal with options",
+			args: args{
+				got:  mapsTestUsers,
+				key:  "bob",
+				want: mapsTestAddress{City: "Bergen"},
+				opts: []EqualOption{IgnoreFields("Zip")},
+			},
+			want:           true,
+			wantTestFailed: false,
+		},
+		{
+			name:           "should fail when map doesn't have entry key",
+			args:           args{got: mapsTestUsers, key: "carol", want: mapsTestAddress{}},
+			want:           false,
+			wantTestFailed: true,
+			wantMessage:    []string{`Observed map must have expected entry for key "carol"`},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Given
+			assert := New(t)
+			dummyT := &fakeT{}
+			dummyAssert := New(dummyT)
+
+			// When
+			got := dummyAssert(tt.args.got).HasEntry(tt.args.key, tt.args.want, tt.args.opts...)
+
+			// Then
+			assert(got).Equals(tt.want)
+			assert(dummyT.failed).Equals(tt.wantTestFailed)
+			for _, want := range tt.wantMessage {
+				assert(strings.Contains(strings.Join(dummyT.logs, "\n"), want)).IsTrue()
+			}
+		})
+	}
+}
+
+func TestContainsSubMap(t *testing.T) {
+	type address struct {
+		City string
+	}
+	type args struct {
+		got  interface{}
+		want interface{}
+	}
+	tests := []struct {
+		name           string
+		args           args
+		want           bool
+		wantTestFailed bool
+		wantMessage    []string
+	}{
+		{
+			name:           "should pass when map contains sub map",
+			args:           args{got: map[string]int{"a": 1, "b": 2, "c": 3}, want: map[string]int{"a": 1, "c": 3}},
+			want:           true,
+			wantTestFailed: false,
+		},
+		{
+			name:           "should pass when map contains empty sub map",
+			args:           args{got: map[string]int{"a": 1}, want: map[string]int{}},
+			want:           true,
+			wantTestFailed: false,
+		},
+		{
+			name:           "should fail when map doesn't contain sub map",
+			args:           args{got: map[string]int{"a": 1, "b": 2}, want: map[string]int{"a": 2, "c": 3}},
+			want:           false,
+			wantTestFailed: true,
+			wantMessage:    []string{`["a"]: want 2, got 1`, `["c"]: missing, want 3`},
+		},
+		{
+			name: "should report differences by key",
+			args: args{
+				got:  map[string]address{"alice": {City: "Oslo"}},
+				want: map[string]address{"alice": {City: "Bergen"}, "bob": {City: "Oslo"}},
+			},
+			want:           false,
+			wantTestFailed: true,
+			wantMessage:    []string{`["alice"].City: want "Bergen", got "Oslo"`, `["bob"]: missing, want {Oslo}`},
+		},
+		{
+			name:           "should fail when want sub map but get non-map",
+			args:           args{got: map[string]int{"a": 1}, want: []string{"a"}},
+			want:           false,
+			wantTestFailed: true,
+			wantMessage:    []string{"Invalid argument"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Given
+			assert := New(t)
+			dummyT := &fakeT{}
+			dummyAssert := New(dummyT)
+
+			// When
+			got := dummyAssert(tt.args.got).ContainsSubMap(tt.args.want)
+
+			// Then
+			assert(got).Equals(tt.want)
+			assert(dummyT.failed).Equals(tt.wantTestFailed)
+			for _, want := range tt.wantMessage {
+				assert(strings.Contains(strings.Join(dummyT.logs, "\n"), want)).IsTrue()
+			}
+		})
+	}
+}
+
+func TestIgnoringOrderEqualsKeysIn(t *testing.T) {
+	type args struct {
+		got  interface{}
+		want interface{}
+	}
+	tests := []struct {
+		name           string
+		args           args
+		want           bool
+		wantTestFailed bool
+		wantMessage    []string
+	}{
+		{
+			name:           "should pass when map keys equal ignoring order",
+			args:           args{got: mapsTestUsers, want: []string{"bob", "alice"}},
+			want:           true,
+			wantTestFailed: false,
+		},
+		{
+			name:           "should fail when map keys differ",
+			args:           args{got: mapsTestUsers, want: []string{"bob", "carol"}},
+			want:           false,
+			wantTestFailed: true,
+			wantMessage:    []string{`Missing keys: ["carol"]`, `Unexpected keys: ["alice"]`},
+		},
+		{
+			name:           "should fail when want keys but get non-list",
+			args:           args{got: mapsTestUsers, want: "bob"},
+			want:           false,
+			wantTestFailed: true,
+			wantMessage:    []string{"expected value must be a slice or array"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Given
+			assert := New(t)
+			dummyT := &fakeT{}
+			dummyAssert := New(dummyT)
+
+			// When
+			got := dummyAssert(tt.args.got).IgnoringOrderEqualsKeysIn(tt.args.want)
+
+			// Then
+			assert(got).Equals(tt.want)
+			assert(dummyT.failed).Equals(tt.wantTestFailed)
+			for _, want := range tt.wantMessage {
+				assert(strings.Contains(strings.Join(dummyT.logs, "\n"), want)).IsTrue()
+			}
+		})
+	}
+}
@@ -0,0 +1,39 @@
+package assert
+
+import "fmt"
+
+// Matches asserts the observed value matches the 'want' argument, where zero values in 'want' mean
+// "don't care". It's useful when only a few fields of a large struct are of interest.
+//
+// Values are compared like in the Equals method, except that:
+//   - zero-valued struct fields, slice and array elements and map values in 'want' match any
+//     value, at any depth
+//   - map keys that are only in the observed map are ignored
+//   - non-nil slices in 'want' must have the same length as the observed slices
+//
+// Only the specified fields that differ are reported. The comparison can be adjusted with the same
+// options as the Equals method. If the values don't match, the function under test is marked as
+// having failed.
+//
+//	Example:
+//		assert(resp).Matches(User{Name: "Alice", Address: Address{City: "Oslo"}})
+func (a asserter) Matches(want interface{}, opts ...EqualOption) bool {
+	if a.navigationFailed {
+		return false
+	}
+	want, opts = a.jsonWant(want, opts)
+	cfg, err := newEqualConfig(opts)
+	if err == nil && want == nil {
+		err = fmt.Errorf("expected value must not be nil")
+	}
+	if err != nil {
+		a.errorf(fmt.Sprintf("Invalid argument: %v", err), want, true)
+		return false
+	}
+	if diffs := partialDiff(want, a.got, cfg); len(diffs) > 0 {
+		a.errorf("Observed value must match the fields specified in expected value", want, true,
+			formatDifferences(diffs)...)
+		return false
+	}
+	return true
+}
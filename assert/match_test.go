@@ -0,0 +1,179 @@
+package assert
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestMatches(t *testing.T) {
+	type address struct {
+		Street string
+		City   string
+	}
+	type user struct {
+		ID        int
+		Name      string
+		Admin     bool
+		Address   *address
+		Tags      []string
+		Labels    map[string]string
+		CreatedAt time.Time
+	}
+	created := time.Date(2021, 1, 1, 12, 0, 0, 0, time.UTC)
+	got := user{
+		ID:        7,
+		Name:      "Alice",
+		Admin:     true,
+		Address:   &address{Street: "Karl Johans gate", City: "Oslo"},
+		Tags:      []string{"a", "b"},
+		Labels:    map[string]string{"team": "core", "env": "prod"},
+		CreatedAt: created,
+	}
+
+	type args struct {
+		got  interface{}
+		want interface{}
+		opts []EqualOption
+	}
+	tests := []struct {
+		name string
+		args args
+		want bool
+	}{
+		{
+			name: "should return true when specified fields equal",
+			args: args{got: got, want: user{Name: "Alice"}},
+			want: true,
+		},
+		{
+			name: "should return false when specified field differs",
+			args: args{got: got, want: user{Name: "Bob"}},
+			want: false,
+		},
+		{
+			name: "should return true when specified nested field equal",
+			args: args{got: got, want: user{Address: &address{City: "Oslo"}}},
+			want: true,
+		},
+		{
+			name: "should return false when specified nested field differs",
+			args: args{got: got, want: user{Address: &address{City: "Bergen"}}},
+			want: false,
+		},
+		{
+			name: "should return false when nested pointer is nil but want specified",
+			args: args{got: user{}, want: user{Address: &address{City: "Oslo"}}},
+			want: false,
+		},
+		{
+			name: "should return true when specified map entries equal",
+			args: args{got: got, want: user{Labels: map[string]string{"team": "core"}}},
+			want: true,
+		},
+		{
+			name: "should return false when specified map entry missing",
+			args: args{got: got, want: user{Labels: map[string]string{"owner": "alice"}}},
+			want: false,
+		},
+		{
+			name: "should return true when zero-valued map entries missing",
+			args: args{got: map[string]int{"a": 5}, want: map[string]int{"a": 0, "z": 0}},
+			want: true,
+		},
+		{
+			name: "should return true when specified slice has don't care elements",
+			args: args{got: got, want: user{Tags: []string{"", "b"}}},
+			want: true,
+		},
+		{
+			name: "should return false when specified slice has other length",
+			args: args{got: got, want: user{Tags: []string{"a"}}},
+			want: false,
+		},
+		{
+			name: "should return true when specified time equal in other location",
+			args: args{got: got, want: user{CreatedAt: created.In(time.FixedZone("CET", 3600))}},
+			want: true,
+		},
+		{
+			name: "should return true when specified fields equal in slice of structs",
+			args: args{
+				got:  []user{got, {Name: "Bob"}},
+				want: []user{{Name: "Alice"}, {Name: "Bob"}},
+			},
+			want: true,
+		},
+		{
+			name: "should return true when specified field differs but is ignored",
+			args: args{got: got, want: user{ID: 1, Name: "Alice"}, opts: []EqualOption{IgnoreFields("ID")}},
+			want: true,
+		},
+		{
+			name: "should return false when types differ",
+			args: args{got: got, want: address{City: "Oslo"}},
+			want: false,
+		},
+		{
+			name: "should return false when want zero value of other type",
+			args: args{got: 42, want: ""},
+			want: false,
+		},
+		{
+			name: "should return false when want zero struct and get pointer",
+			args: args{got: &got, want: user{}},
+			want: false,
+		},
+		{
+			name: "should return false when want zero struct and get nil",
+			args: args{got: nil, want: user{}},
+			want: false,
+		},
+		{
+			name: "should return true when want zero struct",
+			args: args{got: got, want: user{}},
+			want: true,
+		},
+		{
+			name: "should return false when want nil",
+			args: args{got: got, want: nil},
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Given
+			assert := NewFatal(t)
+			dummyT := &fakeT{}
+			dummyAssert := New(dummyT)
+
+			// When
+			got := dummyAssert(tt.args.got).Matches(tt.args.want, tt.args.opts...)
+
+			// Then
+			assert(got).Equals(tt.want)
+			assert(dummyT.failed).Equals(!tt.want)
+		})
+	}
+}
+
+func TestMatchesReportsOnlySpecifiedFields(t *testing.T) {
+	// Given
+	assert := New(t)
+	type user struct {
+		ID   int
+		Name string
+		City string
+	}
+	dummyT := &fakeT{}
+	dummyAssert := New(dummyT)
+
+	// When
+	dummyAssert(user{ID: 1, Name: "Alice", City: "Oslo"}).Matches(user{Name: "Bob"})
+
+	// Then
+	assert(len(dummyT.logs)).Equals(1)
+	assert(strings.Contains(dummyT.logs[0], `.Name: want "Bob", got "Alice"`)).IsTrue()
+	assert(strings.Contains(dummyT.logs[0], ".ID")).IsFalse()
+	assert(strings.Contains(dummyT.logs[0], ".City")).IsFalse()
+}
@@ -0,0 +1,144 @@
+package assert
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// navigate returns an asserter for a value nested in the observed value, reached through 'path'.
+func (a asserter) navigate(got interface{}, path string) asserter {
+	return asserter{
+		got:              got,
+		t:                a.t,
+		fatal:            a.fatal,
+		path:             a.path + path,
+		jsonCfg:          a.jsonCfg,
+		navigationFailed: a.navigationFailed,
+	}
+}
+
+// failNavigation reports the failure to reach a nested value and returns an asserter whose
+// assertions fail without being reported. Failures to navigate from such an asserter aren't
+// reported either.
+func (a asserter) failNavigation(msg string, want interface{}, hasWant bool, path string) asserter {
+	failed := a.navigate(nil, path)
+	failed.navigationFailed = true
+	if !a.navigationFailed {
+		a.errorf(msg, want, hasWant)
+	}
+	return failed
+}
+
+// indirect dereferences pointers and unwraps interfaces until reaching a value of another kind.
+// The second return value is false if a nil pointer or interface was reached.
+func indirect(v reflect.Value) (reflect.Value, bool) {
+	for v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return v, false
+		}
+		v = v.Elem()
+	}
+	return v, v.IsValid()
+}
+
+// Field returns an asserter for the struct field named by 'path', which may be a dot-separated
+// path to a nested field. Pointers and interfaces along the path are dereferenced, and segments
+// of the path may also name keys in maps with string keys. If the field doesn't exist, is
+// unexported or if a nil pointer is reached, the function under test is marked as having failed,
+// and assertions made on the returned asserter fail without being reported.
+//
+//	Example:
+//		assert(resp).Field("User.Address.City").Equals("Oslo")
+func (a asserter) Field(path string) asserter {
+	value := reflect.ValueOf(a.got)
+	traversed := "(root)"
+	for _, name := range strings.Split(path, ".") {
+		current, ok := indirect(value)
+		if !ok {
+			return a.failNavigation(fmt.Sprintf("Nil value at %s, can't access field %q", traversed, name), path, true, "."+path)
+		}
+
+		switch current.Kind() {
+		case reflect.Struct:
+			field, found := current.Type().FieldByName(name)
+			if !found {
+				return a.failNavigation(fmt.Sprintf("Field %q doesn't exist in %s", name, current.Type()), path, true, "."+path)
+			}
+			if field.PkgPath != "" {
+				return a.failNavigation(fmt.Sprintf("Field %q of %s is unexported", name, current.Type()), path, true, "."+path)
+			}
+			fieldValue, err := current.FieldByIndexErr(field.Index)
+			if err != nil {
+				return a.failNavigation(fmt.Sprintf("Nil embedded struct pointer in %s, can't access field %q: %v",
+					current.Type(), name, err), path, true, "."+path)
+			}
+			value = fieldValue
+		case reflect.Map:
+			if current.Type().Key().Kind() != reflect.String {
+				return a.failNavigation(fmt.Sprintf("Map %s doesn't have string keys, use Key instead", current.Type()),
+					path, true, "."+path)
+			}
+			value = current.MapIndex(reflect.ValueOf(name).Convert(current.Type().Key()))
+			if !value.IsValid() {
+				return a.failNavigation(fmt.Sprintf("Key %q doesn't exist in map", name), path, true, "."+path)
+			}
+		default:
+			return a.failNavigation(fmt.Sprintf("Can't access field %q of %s", name, current.Type()), path, true, "."+path)
+		}
+		traversed = strings.TrimPrefix(traversed, "(root)") + "." + name
+	}
+	return a.navigate(value.Interface(), "."+path)
+}
+
+// Index returns an asserter for the element at index 'i' of the observed slice or array. Pointers
+// to slices and arrays are dereferenced. If the observed value isn't a slice or array, or if the
+// index is out of range, the function under test is marked as having failed, and assertions made
+// on the returned asserter fail without being reported.
+//
+//	Example:
+//		assert(list).Index(2).IsNotNil()
+func (a asserter) Index(i int) asserter {
+	path := fmt.Sprintf("[%d]", i)
+	value, ok := indirect(reflect.ValueOf(a.got))
+	if !ok || !isListKind(value.Kind()) {
+		return a.failNavigation("Observed value must be a slice or array", i, true, path)
+	}
+	if i < 0 || i >= value.Len() {
+		return a.failNavigation(fmt.Sprintf("Index %d out of range for length %d", i, value.Len()), i, true, path)
+	}
+	return a.navigate(value.Index(i).Interface(), path)
+}
+
+// Key returns an asserter for the value of the key 'key' in the observed map. Pointers to maps
+// are dereferenced, and keys are compared like in the Equals method. If the observed value isn't
+// a map, or if the key doesn't exist, the function under test is marked as having failed, and
+// assertions made on the returned asserter fail without being reported.
+//
+//	Example:
+//		assert(headers).Key("Content-Type").Equals([]string{"application/json"})
+func (a asserter) Key(key interface{}) asserter {
+	path := fmt.Sprintf("[%s]", formatValue(reflect.ValueOf(key)))
+	value, ok := indirect(reflect.ValueOf(a.got))
+	if !ok || value.Kind() != reflect.Map {
+		return a.failNavigation("Observed value must be a map", key, true, path)
+	}
+	elem, found := lookup(value, key)
+	if !found {
+		return a.failNavigation("Observed map must have expected key", key, true, path)
+	}
+	return a.navigate(elem.Interface(), path)
+}
+
+// Deref returns an asserter for the value the observed pointer points to. If the observed value
+// isn't a non-nil pointer, the function under test is marked as having failed, and assertions
+// made on the returned asserter fail without being reported.
+//
+//	Example:
+//		assert(ptr).Deref().Equals(5)
+func (a asserter) Deref() asserter {
+	if a.got == nil || reflect.TypeOf(a.got).Kind() != reflect.Ptr || isNil(a.got) {
+		return a.failNavigation("Observed value must be a non-nil pointer", nil, false, "")
+	}
+	return a.navigate(reflect.ValueOf(a.got).Elem().Interface(), "")
+}
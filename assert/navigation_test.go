@@ -0,0 +1,424 @@
+package assert
+
+import (
+	"strings"
+	"testing"
+)
+
+type embeddedInner struct{ X int }
+
+type embeddingOuter struct{ *embeddedInner }
+
+type navigationTestAddress struct {
+	City string
+	zip  string
+}
+
+type navigationTestUser struct {
+	Name    string
+	Address *navigationTestAddress
+	Tags    map[string]string
+}
+
+type navigationTestResponse struct {
+	User  navigationTestUser
+	Users []*navigationTestUser
+}
+
+var navigationTestResp = navigationTestResponse{
+	User: navigationTestUser{
+		Name:    "alice",
+		Address: &navigationTestAddress{City: "Oslo", zip: "0150"},
+		Tags:    map[string]string{"role": "admin"},
+	},
+	Users: []*navigationTestUser{{Name: "bob"}, nil},
+}
+
+func TestField(t *testing.T) {
+	type args struct {
+		got  interface{}
+		path string
+		want interface{}
+	}
+	tests := []struct {
+		name           string
+		args           args
+		want           bool
+		wantTestFailed bool
+		wantMessage    []string
+	}{
+		{
+			name:           "should pass when nested field equals want",
+			args:           args{got: navigationTestResp, path: "User.Address.City", want: "Oslo"},
+			want:           true,
+			wantTestFailed: false,
+		},
+		{
+			name:           "should fail when nested field doesn't equal want",
+			args:           args{got: &navigationTestResp, path: "User.Address.City", want: "Bergen"},
+			want:           false,
+			wantTestFailed: true,
+			wantMessage:    []string{".User.Address.City: Observed and expected values must be equal"},
+		},
+		{
+			name:           "should pass when field path goes through string keyed map",
+			args:           args{got: navigationTestResp, path: "User.Tags.role", want: "admin"},
+			want:           true,
+			wantTestFailed: false,
+		},
+		{
+			name:           "should fail when field doesn't exist",
+			args:           args{got: navigationTestResp, path: "User.Email", want: ""},
+			want:           false,
+			wantTestFailed: true,
+			wantMessage:    []string{`Field "Email" doesn't exist in assert.navigationTestUser`},
+		},
+		{
+			name:           "should fail when field is unexported",
+			args:           args{got: navigationTestResp, path: "User.Address.zip", want: "0150"},
+			want:           false,
+			wantTestFailed: true,
+			wantMessage:    []string{`Field "zip" of assert.navigationTestAddress is unexported`},
+		},
+		{
+			name:           "should fail when intermediate pointer is nil",
+			args:           args{got: navigationTestUser{}, path: "Address.City", want: ""},
+			want:           false,
+			wantTestFailed: true,
+			wantMessage:    []string{`Nil value at .Address, can't access field "City"`},
+		},
+		{
+			name:           "should fail when promoted field is reached through nil embedded pointer",
+			args:           args{got: embeddingOuter{}, path: "X", want: 0},
+			want:           false,
+			wantTestFailed: true,
+			wantMessage:    []string{"Nil embedded struct pointer in assert.embeddingOuter"},
+		},
+		{
+			name:           "should pass when promoted field is reached through embedded pointer",
+			args:           args{got: embeddingOuter{embeddedInner: &embeddedInner{X: 1}}, path: "X", want: 1},
+			want:           true,
+			wantTestFailed: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Given
+			assert := New(t)
+			dummyT := &fakeT{}
+			dummyAssert := New(dummyT)
+
+			// When
+			got := dummyAssert(tt.args.got).Field(tt.args.path).Equals(tt.args.want)
+
+			// Then
+			assert(got).Equals(tt.want)
+			assert(dummyT.failed).Equals(tt.wantTestFailed)
+			assert(len(dummyT.logs) <= 1).IsTrue()
+			for _, want := range tt.wantMessage {
+				assert(strings.Contains(strings.Join(dummyT.logs, "\n"), want)).IsTrue()
+			}
+		})
+	}
+}
+
+func TestIndex(t *testing.T) {
+	type args struct {
+		got  interface{}
+		i    int
+		want interface{}
+	}
+	tests := []struct {
+		name           string
+		args           args
+		want           bool
+		wantTestFailed bool
+		wantMessage    []string
+	}{
+		{
+			name:           "should pass when indexed element equals want",
+			args:           args{got: navigationTestResp.Users, i: 0, want: &navigationTestUser{Name: "bob"}},
+			want:           true,
+			wantTestFailed: false,
+		},
+		{
+			name:           "should pass when indexed nil element equals nil",
+			args:           args{got: navigationTestResp.Users, i: 1, want: (*navigationTestUser)(nil)},
+			want:           true,
+			wantTestFailed: false,
+		},
+		{
+			name:           "should fail when indexed element doesn't equal want",
+			args:           args{got: []int{1, 2}, i: 1, want: 3},
+			want:           false,
+			wantTestFailed: true,
+			wantMessage:    []string{"[1]: Observed and expected values must be equal"},
+		},
+		{
+			name:           "should fail when index is out of range",
+			args:           args{got: []int{1}, i: 3, want: 1},
+			want:           false,
+			wantTestFailed: true,
+			wantMessage:    []string{"Index 3 out of range for length 1"},
+		},
+		{
+			name:           "should fail when indexing non-list",
+			args:           args{got: navigationTestResp, i: 0, want: nil},
+			want:           false,
+			wantTestFailed: true,
+			wantMessage:    []string{"Observed value must be a slice or array"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Given
+			assert := New(t)
+			dummyT := &fakeT{}
+			dummyAssert := New(dummyT)
+
+			// When
+			got := dummyAssert(tt.args.got).Index(tt.args.i).Equals(tt.args.want)
+
+			// Then
+			assert(got).Equals(tt.want)
+			assert(dummyT.failed).Equals(tt.wantTestFailed)
+			assert(len(dummyT.logs) <= 1).IsTrue()
+			for _, want := range tt.wantMessage {
+				assert(strings.Contains(strings.Join(dummyT.logs, "\n"), want)).IsTrue()
+			}
+		})
+	}
+}
+
+func TestKey(t *testing.T) {
+	type args struct {
+		got  interface{}
+		key  interface{}
+		want interface{}
+	}
+	tests := []struct {
+		name           string
+		args           args
+		want           bool
+		wantTestFailed bool
+		wantMessage    []string
+	}{
+		{
+			name:           "should pass when value of key equals want",
+			args:           args{got: map[int]string{1: "a"}, key: 1, want: "a"},
+			want:           true,
+			wantTestFailed: false,
+		},
+		{
+			name:           "should fail when value of key doesn't equal want",
+			args:           args{got: map[string]int{"a": 1}, key: "a", want: 2},
+			want:           false,
+			wantTestFailed: true,
+			wantMessage:    []string{`["a"]: Observed and expected values must be equal`},
+		},
+		{
+			name:           "should fail when key doesn't exist",
+			args:           args{got: map[int]string{1: "a"}, key: 2, want: ""},
+			want:           false,
+			wantTestFailed: true,
+			wantMessage:    []string{"Observed map must have expected key"},
+		},
+		{
+			name:           "should fail when get non-map",
+			args:           args{got: []string{"a"}, key: 0, want: "a"},
+			want:           false,
+			wantTestFailed: true,
+			wantMessage:    []string{"Observed value must be a map"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Given
+			assert := New(t)
+			dummyT := &fakeT{}
+			dummyAssert := New(dummyT)
+
+			// When
+			got := dummyAssert(tt.args.got).Key(tt.args.key).Equals(tt.args.want)
+
+			// Then
+			assert(got).Equals(tt.want)
+			assert(dummyT.failed).Equals(tt.wantTestFailed)
+			assert(len(dummyT.logs) <= 1).IsTrue()
+			for _, want := range tt.wantMessage {
+				assert(strings.Contains(strings.Join(dummyT.logs, "\n"), want)).IsTrue()
+			}
+		})
+	}
+}
+
+func TestDeref(t *testing.T) {
+	five := 5
+
+	type args struct {
+		got  interface{}
+		want interface{}
+	}
+	tests := []struct {
+		name           string
+		args           args
+		want           bool
+		wantTestFailed bool
+		wantMessage    []string
+	}{
+		{
+			name:           "should pass when dereferenced value equals want",
+			args:           args{got: &five, want: 5},
+			want:           true,
+			wantTestFailed: false,
+		},
+		{
+			name:           "should fail when dereferencing nil pointer",
+			args:           args{got: (*int)(nil), want: 0},
+			want:           false,
+			wantTestFailed: true,
+			wantMessage:    []string{"Observed value must be a non-nil pointer"},
+		},
+		{
+			name:           "should fail when dereferencing non-pointer",
+			args:           args{got: five, want: 5},
+			want:           false,
+			wantTestFailed: true,
+			wantMessage:    []string{"Observed value must be a non-nil pointer"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Given
+			assert := New(t)
+			dummyT := &fakeT{}
+			dummyAssert := New(dummyT)
+
+			// When
+			got := dummyAssert(tt.args.got).Deref().Equals(tt.args.want)
+
+			// Then
+			assert(got).Equals(tt.want)
+			assert(dummyT.failed).Equals(tt.wantTestFailed)
+			assert(len(dummyT.logs) <= 1).IsTrue()
+			for _, want := range tt.wantMessage {
+				assert(strings.Contains(strings.Join(dummyT.logs, "\n"), want)).IsTrue()
+			}
+		})
+	}
+}
+
+func TestChainedNavigation(t *testing.T) {
+	type args struct {
+		got  interface{}
+		want interface{}
+	}
+	tests := []struct {
+		name           string
+		args           args
+		want           bool
+		wantTestFailed bool
+		wantMessage    []string
+	}{
+		{
+			name:           "should pass when navigated value equals want",
+			args:           args{got: navigationTestResp, want: "bob"},
+			want:           true,
+			wantTestFailed: false,
+		},
+		{
+			name:           "should label failure with every navigation step",
+			args:           args{got: navigationTestResp, want: "carol"},
+			want:           false,
+			wantTestFailed: true,
+			wantMessage:    []string{".Users[0].Name: Observed and expected values must be equal"},
+		},
+		{
+			name:           "should report only first failed navigation step",
+			args:           args{got: navigationTestResponse{}, want: "bob"},
+			want:           false,
+			wantTestFailed: true,
+			wantMessage:    []string{"Index 0 out of range for length 0"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Given
+			assert := New(t)
+			dummyT := &fakeT{}
+			dummyAssert := New(dummyT)
+
+			// When
+			got := dummyAssert(tt.args.got).Field("Users").Index(0).Deref().Field("Name").Equals(tt.args.want)
+
+			// Then
+			assert(got).Equals(tt.want)
+			assert(dummyT.failed).Equals(tt.wantTestFailed)
+			assert(len(dummyT.logs) <= 1).IsTrue()
+			for _, want := range tt.wantMessage {
+				assert(strings.Contains(strings.Join(dummyT.logs, "\n"), want)).IsTrue()
+			}
+		})
+	}
+}
+
+func TestIsNilAfterFailedNavigation(t *testing.T) {
+	type args struct {
+		got interface{}
+	}
+	tests := []struct {
+		name           string
+		args           args
+		want           bool
+		wantTestFailed bool
+		wantMessage    []string
+	}{
+		{
+			name:           "should fail when field of non-struct doesn't exist",
+			args:           args{got: "x"},
+			want:           false,
+			wantTestFailed: true,
+			wantMessage:    []string{`Can't access field "Nope" of string`},
+		},
+		{
+			name:           "should fail when field of struct doesn't exist",
+			args:           args{got: navigationTestResp},
+			want:           false,
+			wantTestFailed: true,
+			wantMessage:    []string{`Field "Nope" doesn't exist in assert.navigationTestResponse`},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Given
+			assert := New(t)
+			dummyT := &fakeT{}
+			dummyAssert := New(dummyT)
+
+			// When
+			got := dummyAssert(tt.args.got).Field("Nope").Index(0).Deref().IsNil()
+
+			// Then
+			assert(got).Equals(tt.want)
+			assert(dummyT.failed).Equals(tt.wantTestFailed)
+			assert(len(dummyT.logs)).Equals(1)
+			for _, want := range tt.wantMessage {
+				assert(strings.Contains(strings.Join(dummyT.logs, "\n"), want)).IsTrue()
+			}
+		})
+	}
+}
+
+func TestNavigationFatal(t *testing.T) {
+	// Given
+	assert := New(t)
+	dummyT := &fakeT{}
+	require := NewFatal(dummyT)
+
+	// When
+	got := require(nil).Field("User").IsNil()
+
+	// Then
+	assert(dummyT.fatal).IsTrue()
+	assert(got).IsFalse()
+}
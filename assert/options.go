@@ -0,0 +1,195 @@
+package assert
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// EqualOption configures how the observed and expected values are compared for equality.
+type EqualOption func(*equalConfig)
+
+type equalConfig struct {
+	ignoredFields  map[string]bool
+	nilEqualsEmpty bool
+	comparers      map[reflect.Type]reflect.Value
+	nanEqualsNaN   bool
+	// floatTolerance is non-nil if floating-point values may differ by a tolerance
+	floatTolerance *floatTolerance
+	// ignoreEqualMethods disables the use of Equal and Cmp methods defined on the compared types
+	ignoreEqualMethods bool
+	err                error
+}
+
+func newEqualConfig(opts []EqualOption) (equalConfig, error) {
+	var cfg equalConfig
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	return cfg, cfg.err
+}
+
+// ignores reports whether the value at the path must be left out of the comparison.
+func (cfg equalConfig) ignores(path valuePath) bool {
+	return path.fields != "" && cfg.ignoredFields[path.fields]
+}
+
+type floatTolerance struct {
+	absolute float64
+	relative float64
+}
+
+// floatsEqual compares floating-point values, represented as complex numbers, taking the NaN and
+// tolerance options into account.
+func (cfg equalConfig) floatsEqual(want, got complex128) bool {
+	if want == got || cfg.nanEqualsNaN && complexNaNEqual(want, got) {
+		return true
+	}
+	if cfg.floatTolerance == nil {
+		return false
+	}
+	wantNumber, gotNumber := number{value: want}, number{value: got}
+	return absoluteDistance(gotNumber, wantNumber) <= cfg.floatTolerance.absolute ||
+		relativeDistance(gotNumber, wantNumber) <= cfg.floatTolerance.relative
+}
+
+// compare compares want and got using a custom comparer registered for their type or, unless
+// disabled, using an equality method defined on the type. The second return value is false if
+// there's no such comparer or method, or if it can't be called because the values were obtained
+// through unexported struct fields.
+func (cfg equalConfig) compare(want, got reflect.Value) (equal bool, ok bool) {
+	if !want.CanInterface() || !got.CanInterface() {
+		return false, false
+	}
+	if comparer, found := cfg.comparers[want.Type()]; found {
+		return comparer.Call([]reflect.Value{want, got})[0].Bool(), true
+	}
+	if cfg.ignoreEqualMethods {
+		return false, false
+	}
+	return compareUsingMethod(want, got)
+}
+
+// compareUsingMethod compares want and got using their type's Equal(T) bool method, such as the
+// ones defined by time.Time and net.IP, or Cmp(T) int method, such as the one defined by *big.Int.
+// The second return value is false if the type has no such method.
+func compareUsingMethod(want, got reflect.Value) (equal bool, ok bool) {
+	switch want.Kind() {
+	case reflect.Interface:
+		// The dynamic values are compared once the interfaces have been unwrapped
+		return false, false
+	case reflect.Ptr, reflect.Map, reflect.Slice:
+		// Nil values are left to the default comparison, since their methods may not handle nil
+		if want.IsNil() || got.IsNil() {
+			return false, false
+		}
+	}
+
+	typ := want.Type()
+	if method, found := typ.MethodByName("Equal"); found && hasSignature(method, typ, reflect.Bool) {
+		return method.Func.Call([]reflect.Value{want, got})[0].Bool(), true
+	}
+	if method, found := typ.MethodByName("Cmp"); found && hasSignature(method, typ, reflect.Int) {
+		return method.Func.Call([]reflect.Value{want, got})[0].Int() == 0, true
+	}
+	return false, false
+}
+
+// hasSignature reports whether the method is of the form func(T) R, where T is the receiver type
+// and R is of the result kind.
+func hasSignature(method reflect.Method, typ reflect.Type, result reflect.Kind) bool {
+	fnType := method.Type
+	return fnType.NumIn() == 2 && fnType.In(1) == typ && fnType.NumOut() == 1 && fnType.Out(0).Kind() == result
+}
+
+// IgnoreFields leaves the named struct fields out of the comparison. Nested fields are named
+// by their dot-separated path from the compared value, e.g. "Meta.CreatedAt". Slice, array and
+// map elements are transparent, so "Items.ID" refers to the ID field of every element in the
+// Items slice. String map keys are named like fields.
+//
+//	Example:
+//		assert(got).Equals(want, assert.IgnoreFields("ID", "Meta.CreatedAt"))
+func IgnoreFields(paths ...string) EqualOption {
+	return func(cfg *equalConfig) {
+		if cfg.ignoredFields == nil {
+			cfg.ignoredFields = make(map[string]bool)
+		}
+		for _, path := range paths {
+			cfg.ignoredFields[path] = true
+		}
+	}
+}
+
+// TreatNilAndEmptyAsEqual makes nil slices and maps equal to empty ones of the same type.
+func TreatNilAndEmptyAsEqual() EqualOption {
+	return func(cfg *equalConfig) {
+		cfg.nilEqualsEmpty = true
+	}
+}
+
+// NaNEqualsNaN makes NaN values equal to each other. By default, NaN is unequal to every value,
+// including itself.
+func NaNEqualsNaN() EqualOption {
+	return func(cfg *equalConfig) {
+		cfg.nanEqualsNaN = true
+	}
+}
+
+// WithFloatTolerance makes floating-point and complex values equal if they differ by at most
+// 'absolute', or if their relative error, |got - want| / |want|, is at most 'relative'. It applies
+// to every such value reached during the comparison, at any depth. A zero tolerance disables the
+// corresponding check.
+//
+//	Example:
+//		assert(gotQuote).Equals(wantQuote, assert.WithFloatTolerance(1e-9, 1e-6))
+func WithFloatTolerance(absolute, relative float64) EqualOption {
+	return func(cfg *equalConfig) {
+		if !(absolute >= 0) || !(relative >= 0) {
+			cfg.err = fmt.Errorf("float tolerances must be non-negative, got %v and %v", absolute, relative)
+			return
+		}
+		cfg.floatTolerance = &floatTolerance{absolute: absolute, relative: relative}
+	}
+}
+
+// IgnoreEqualMethods disables the use of equality methods defined on the compared types. By
+// default, values of types with an Equal(T) bool method, such as time.Time and net.IP, or a
+// Cmp(T) int method, such as *big.Int, are compared using that method at any depth, except for
+// values reached through unexported struct fields, on which methods can't be called.
+func IgnoreEqualMethods() EqualOption {
+	return func(cfg *equalConfig) {
+		cfg.ignoreEqualMethods = true
+	}
+}
+
+// WithComparer registers a custom comparer, which is used instead of the default comparison
+// for all values of its argument type, at any depth. The comparer must be a function of the form
+// func(a, b T) bool. Values reached through unexported struct fields are compared using the
+// default comparison.
+//
+//	Example:
+//		assert(got).Equals(want, assert.WithComparer(func(a, b time.Time) bool {
+//			return a.Truncate(time.Second).Equal(b.Truncate(time.Second))
+//		}))
+func WithComparer(comparer interface{}) EqualOption {
+	return func(cfg *equalConfig) {
+		if !isComparer(comparer) {
+			cfg.err = fmt.Errorf("comparer must be a non-nil func(a, b T) bool, got %T", comparer)
+			return
+		}
+		fn := reflect.ValueOf(comparer)
+		fnType := fn.Type()
+		if cfg.comparers == nil {
+			cfg.comparers = make(map[reflect.Type]reflect.Value)
+		}
+		cfg.comparers[fnType.In(0)] = fn
+	}
+}
+
+func isComparer(comparer interface{}) bool {
+	if !isFunc(comparer) || isNil(comparer) {
+		return false
+	}
+	fnType := reflect.TypeOf(comparer)
+	return fnType.NumIn() == 2 && fnType.NumOut() == 1 && fnType.In(0) == fnType.In(1) &&
+		fnType.Out(0).Kind() == reflect.Bool
+}
@@ -0,0 +1,391 @@
+package assert
+
+import (
+	"math/big"
+	"net"
+	"testing"
+	"time"
+)
+
+func TestEqualsWithOptions(t *testing.T) {
+	type meta struct {
+		CreatedAt time.Time
+		Version   int
+	}
+	type item struct {
+		ID   int
+		Name string
+	}
+	type entity struct {
+		ID    int
+		Name  string
+		Meta  meta
+		Items []item
+		Tags  map[string]string
+	}
+	now := time.Date(2021, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	type args struct {
+		got  interface{}
+		want interface{}
+		opts []EqualOption
+	}
+	tests := []struct {
+		name string
+		args args
+		want bool
+	}{
+		{
+			name: "should return true when ignored top-level field differs",
+			args: args{
+				got:  entity{ID: 1, Name: "a"},
+				want: entity{ID: 2, Name: "a"},
+				opts: []EqualOption{IgnoreFields("ID")},
+			},
+			want: true,
+		},
+		{
+			name: "should return false when non-ignored field differs",
+			args: args{
+				got:  entity{ID: 1, Name: "a"},
+				want: entity{ID: 2, Name: "b"},
+				opts: []EqualOption{IgnoreFields("ID")},
+			},
+			want: false,
+		},
+		{
+			name: "should return true when ignored nested field differs",
+			args: args{
+				got:  entity{Meta: meta{CreatedAt: now, Version: 1}},
+				want: entity{Meta: meta{CreatedAt: now.Add(time.Hour), Version: 1}},
+				opts: []EqualOption{IgnoreFields("Meta.CreatedAt")},
+			},
+			want: true,
+		},
+		{
+			name: "should return true when ignored field differs in slice elements",
+			args: args{
+				got:  entity{Items: []item{{ID: 1, Name: "a"}, {ID: 2, Name: "b"}}},
+				want: entity{Items: []item{{ID: 3, Name: "a"}, {ID: 4, Name: "b"}}},
+				opts: []EqualOption{IgnoreFields("Items.ID")},
+			},
+			want: true,
+		},
+		{
+			name: "should return true when ignored field differs in top-level slice elements",
+			args: args{
+				got:  []item{{ID: 1, Name: "a"}},
+				want: []item{{ID: 2, Name: "a"}},
+				opts: []EqualOption{IgnoreFields("ID")},
+			},
+			want: true,
+		},
+		{
+			name: "should return true when ignored map key differs",
+			args: args{
+				got:  entity{Tags: map[string]string{"a": "1", "trace": "x"}},
+				want: entity{Tags: map[string]string{"a": "1"}},
+				opts: []EqualOption{IgnoreFields("Tags.trace")},
+			},
+			want: true,
+		},
+		{
+			name: "should return false when want nil slice but get empty slice",
+			args: args{
+				got:  entity{Items: []item{}},
+				want: entity{},
+			},
+			want: false,
+		},
+		{
+			name: "should return true when want nil slice but get empty slice and nil equals empty",
+			args: args{
+				got:  entity{Items: []item{}, Tags: map[string]string{}},
+				want: entity{},
+				opts: []EqualOption{TreatNilAndEmptyAsEqual()},
+			},
+			want: true,
+		},
+		{
+			name: "should return true when custom comparer considers nested values equal",
+			args: args{
+				got:  entity{Meta: meta{CreatedAt: now}},
+				want: entity{Meta: meta{CreatedAt: now.Add(time.Millisecond)}},
+				opts: []EqualOption{WithComparer(func(a, b time.Time) bool {
+					return a.Truncate(time.Second).Equal(b.Truncate(time.Second))
+				})},
+			},
+			want: true,
+		},
+		{
+			name: "should return false when custom comparer considers values unequal",
+			args: args{
+				got:  []string{"a"},
+				want: []string{"b"},
+				opts: []EqualOption{WithComparer(func(a, b string) bool { return len(a) != len(b) })},
+			},
+			want: false,
+		},
+		{
+			name: "should return false when comparer has invalid signature",
+			args: args{
+				got:  1,
+				want: 1,
+				opts: []EqualOption{WithComparer(func(a int) bool { return true })},
+			},
+			want: false,
+		},
+		{
+			name: "should return false when comparer is nil",
+			args: args{
+				got:  1,
+				want: 1,
+				opts: []EqualOption{WithComparer(nil)},
+			},
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Given
+			assert := NewFatal(t)
+			dummyT := &fakeT{}
+			dummyAssert := New(dummyT)
+
+			// When
+			got := dummyAssert(tt.args.got).Equals(tt.args.want, tt.args.opts...)
+
+			// Then
+			assert(got).Equals(tt.want)
+			assert(dummyT.failed).Equals(!tt.want)
+		})
+	}
+}
+
+func TestEqualsUsingEqualMethods(t *testing.T) {
+	type event struct {
+		At time.Time
+		IP net.IP
+		N  *big.Int
+	}
+	utc := time.Date(2021, 1, 1, 12, 0, 0, 0, time.UTC)
+	oslo := utc.In(time.FixedZone("CET", 3600))
+	zero := new(big.Int)
+	zeroFromSub := new(big.Int).Sub(big.NewInt(5), big.NewInt(5))
+
+	type args struct {
+		got  interface{}
+		want interface{}
+		opts []EqualOption
+	}
+	tests := []struct {
+		name string
+		args args
+		want bool
+	}{
+		{
+			name: "should return true when same instant in different locations",
+			args: args{got: oslo, want: utc},
+			want: true,
+		},
+		{
+			name: "should return true when same nested instant in different locations",
+			args: args{got: event{At: oslo}, want: event{At: utc}},
+			want: true,
+		},
+		{
+			name: "should return false when different instants",
+			args: args{got: event{At: utc.Add(time.Second)}, want: event{At: utc}},
+			want: false,
+		},
+		{
+			name: "should return true when IPv4 and IPv4-in-IPv6 forms of same IP",
+			args: args{got: event{IP: net.IPv4(10, 0, 0, 1).To4()}, want: event{IP: net.IPv4(10, 0, 0, 1)}},
+			want: true,
+		},
+		{
+			name: "should return true when big ints have equal values",
+			args: args{got: event{N: zeroFromSub}, want: event{N: zero}},
+			want: true,
+		},
+		{
+			name: "should return false when big ints have different values",
+			args: args{got: event{N: big.NewInt(1)}, want: event{N: zero}},
+			want: false,
+		},
+		{
+			name: "should return false when same instant in different locations in unexported field",
+			args: args{got: struct{ at time.Time }{oslo}, want: struct{ at time.Time }{utc}},
+			want: false,
+		},
+		{
+			name: "should return true when same instant in same location in unexported field",
+			args: args{got: struct{ at time.Time }{utc}, want: struct{ at time.Time }{utc}},
+			want: true,
+		},
+		{
+			name: "should return false when same instant in different locations and equal methods ignored",
+			args: args{got: oslo, want: utc, opts: []EqualOption{IgnoreEqualMethods()}},
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Given
+			assert := NewFatal(t)
+			dummyT := &fakeT{}
+			dummyAssert := New(dummyT)
+
+			// When
+			got := dummyAssert(tt.args.got).Equals(tt.args.want, tt.args.opts...)
+
+			// Then
+			assert(got).Equals(tt.want)
+			assert(dummyT.failed).Equals(!tt.want)
+		})
+	}
+}
+
+func TestEqualsWithFloatTolerance(t *testing.T) {
+	type line struct {
+		Price    float64
+		Discount float32
+	}
+	type quote struct {
+		Total float64
+		Lines []line
+		Taxes map[string]complex128
+	}
+
+	type args struct {
+		got  interface{}
+		want interface{}
+		opts []EqualOption
+	}
+	tests := []struct {
+		name string
+		args args
+		want bool
+	}{
+		{
+			name: "should return false when nested floats differ slightly without tolerance",
+			args: args{
+				got:  quote{Total: 0.30000000000000004},
+				want: quote{Total: 0.3},
+			},
+			want: false,
+		},
+		{
+			name: "should return true when nested floats within absolute tolerance",
+			args: args{
+				got:  quote{Total: 0.30000000000000004, Lines: []line{{Price: 10.0000001, Discount: 0.1}}},
+				want: quote{Total: 0.3, Lines: []line{{Price: 10, Discount: 0.1}}},
+				opts: []EqualOption{WithFloatTolerance(1e-6, 0)},
+			},
+			want: true,
+		},
+		{
+			name: "should return true when nested floats within relative tolerance",
+			args: args{
+				got:  quote{Total: 1000001},
+				want: quote{Total: 1000000},
+				opts: []EqualOption{WithFloatTolerance(0, 1e-5)},
+			},
+			want: true,
+		},
+		{
+			name: "should return false when nested floats outside both tolerances",
+			args: args{
+				got:  quote{Lines: []line{{Price: 10.1}}},
+				want: quote{Lines: []line{{Price: 10}}},
+				opts: []EqualOption{WithFloatTolerance(1e-3, 1e-3)},
+			},
+			want: false,
+		},
+		{
+			name: "should return true when complex map values within tolerance",
+			args: args{
+				got:  quote{Taxes: map[string]complex128{"vat": complex(1, 1e-9)}},
+				want: quote{Taxes: map[string]complex128{"vat": complex(1, 0)}},
+				opts: []EqualOption{WithFloatTolerance(1e-6, 0)},
+			},
+			want: true,
+		},
+		{
+			name: "should return false when other fields differ with tolerance",
+			args: args{
+				got:  map[string]interface{}{"total": 0.30000000000000004, "currency": "EUR"},
+				want: map[string]interface{}{"total": 0.3, "currency": "NOK"},
+				opts: []EqualOption{WithFloatTolerance(1e-6, 0)},
+			},
+			want: false,
+		},
+		{
+			name: "should return false when tolerance is negative",
+			args: args{
+				got:  0.3,
+				want: 0.3,
+				opts: []EqualOption{WithFloatTolerance(-1, 0)},
+			},
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Given
+			assert := NewFatal(t)
+			dummyT := &fakeT{}
+			dummyAssert := New(dummyT)
+
+			// When
+			got := dummyAssert(tt.args.got).Equals(tt.args.want, tt.args.opts...)
+
+			// Then
+			assert(got).Equals(tt.want)
+			assert(dummyT.failed).Equals(!tt.want)
+		})
+	}
+}
+
+func TestIgnoringOrderEqualsElementsInWithFloatTolerance(t *testing.T) {
+	type args struct {
+		got  interface{}
+		want interface{}
+	}
+	tests := []struct {
+		name string
+		args args
+		want bool
+	}{
+		{
+			name: "should return true when pairing exists but first match isn't part of it",
+			args: args{got: []float64{0.95, 1.05}, want: []float64{1.0, 0.9}},
+			want: true,
+		},
+		{
+			name: "should return true when pairing requires rematching several elements",
+			args: args{got: []float64{1.05, 1.15, 0.95}, want: []float64{1.0, 1.1, 0.9}},
+			want: true,
+		},
+		{
+			name: "should return false when no pairing exists",
+			args: args{got: []float64{0.95, 0.8}, want: []float64{1.0, 0.9}},
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Given
+			assert := NewFatal(t)
+			dummyT := &fakeT{}
+			dummyAssert := New(dummyT)
+
+			// When
+			got := dummyAssert(tt.args.got).IgnoringOrderEqualsElementsIn(tt.args.want, WithFloatTolerance(0.06, 0))
+
+			// Then
+			assert(got).Equals(tt.want)
+			assert(dummyT.failed).Equals(!tt.want)
+		})
+	}
+}
@@ -0,0 +1,266 @@
+package assert
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"reflect"
+	"time"
+)
+
+var (
+	timeType       = reflect.TypeOf(time.Time{})
+	jsonNumberType = reflect.TypeOf(json.Number(""))
+	decimalType    = reflect.TypeOf(decimal{})
+)
+
+// decimal is the exact value of a json.Number that doesn't fit in an int64.
+type decimal struct {
+	rat *big.Rat
+}
+
+type kindClass int
+
+const (
+	unorderedClass kindClass = iota
+	signedClass
+	unsignedClass
+	floatClass
+	decimalClass
+	stringClass
+	timeClass
+)
+
+func classify(v reflect.Value) kindClass {
+	switch v.Type() {
+	case timeType:
+		return timeClass
+	case decimalType:
+		return decimalClass
+	case jsonNumberType:
+		// Valid JSON numbers are converted to other types first
+		return unorderedClass
+	}
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return signedClass
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return unsignedClass
+	case reflect.Float32, reflect.Float64:
+		return floatClass
+	case reflect.String:
+		return stringClass
+	default:
+		return unorderedClass
+	}
+}
+
+// compareOrdered returns -1 if a is less than b, 0 if they're equal and +1 if a is greater than b.
+// Integers, unsigned integers and floats can be compared with each other, including named types
+// such as time.Duration, and json.Number values are compared as numbers too. Numbers of different
+// types are compared exactly. Strings can only be compared with strings and time.Time values with
+// time.Time values.
+func compareOrdered(a, b interface{}) (int, error) {
+	if a == nil || b == nil {
+		return 0, errors.New("nil values can't be ordered")
+	}
+	a, b = fromJSONNumber(a), fromJSONNumber(b)
+	aValue, bValue := reflect.ValueOf(a), reflect.ValueOf(b)
+	aClass, bClass := classify(aValue), classify(bValue)
+	if aClass == unorderedClass || bClass == unorderedClass {
+		return 0, fmt.Errorf("values of type %T and %T can't be ordered", a, b)
+	}
+
+	switch {
+	case aClass == timeClass && bClass == timeClass:
+		aTime, bTime := a.(time.Time), b.(time.Time)
+		switch {
+		case aTime.Before(bTime):
+			return -1, nil
+		case aTime.After(bTime):
+			return 1, nil
+		default:
+			return 0, nil
+		}
+	case aClass == stringClass && bClass == stringClass:
+		return compareValues(aValue.String(), bValue.String()), nil
+	case aClass == timeClass || bClass == timeClass || aClass == stringClass || bClass == stringClass:
+		return 0, fmt.Errorf("values of type %T and %T can't be compared with each other", a, b)
+	case aClass == floatClass || bClass == floatClass || aClass == decimalClass || bClass == decimalClass:
+		if isNaNValue(aValue) || isNaNValue(bValue) {
+			return 0, errors.New("NaN can't be ordered")
+		}
+		if aInf, bInf := infSign(aValue), infSign(bValue); aInf != 0 || bInf != 0 {
+			return compareValues(aInf, bInf), nil
+		}
+		return toRat(aValue).Cmp(toRat(bValue)), nil
+	case aClass == signedClass && bClass == signedClass:
+		return compareValues(aValue.Int(), bValue.Int()), nil
+	case aClass == unsignedClass && bClass == unsignedClass:
+		return compareValues(aValue.Uint(), bValue.Uint()), nil
+	case aClass == signedClass:
+		if aValue.Int() < 0 {
+			return -1, nil
+		}
+		return compareValues(uint64(aValue.Int()), bValue.Uint()), nil
+	default:
+		if bValue.Int() < 0 {
+			return 1, nil
+		}
+		return compareValues(aValue.Uint(), uint64(bValue.Int())), nil
+	}
+}
+
+// fromJSONNumber returns the value of a json.Number as an int64, or as a decimal if it doesn't
+// fit in an int64, so that it's ordered exactly like other numbers. Other values, and json.Number
+// values that aren't valid numbers, are returned unchanged.
+func fromJSONNumber(v interface{}) interface{} {
+	n, ok := v.(json.Number)
+	if !ok {
+		return v
+	}
+	if i, err := n.Int64(); err == nil {
+		return i
+	}
+	if rat, ok := new(big.Rat).SetString(n.String()); ok {
+		return decimal{rat: rat}
+	}
+	return v
+}
+
+// toRat returns the finite number as a big.Rat, which holds every integer, float and decimal
+// exactly, so that integers beyond 2^53 and decimals aren't rounded when they're compared with
+// floats. NaN and infinities can't be represented and must be checked for first.
+func toRat(v reflect.Value) *big.Rat {
+	switch classify(v) {
+	case signedClass:
+		return new(big.Rat).SetInt64(v.Int())
+	case unsignedClass:
+		return new(big.Rat).SetInt(new(big.Int).SetUint64(v.Uint()))
+	case decimalClass:
+		return v.Interface().(decimal).rat
+	default:
+		return new(big.Rat).SetFloat64(v.Float())
+	}
+}
+
+// infSign returns +1 for positive infinity, -1 for negative infinity and 0 for other numbers.
+func infSign(v reflect.Value) int {
+	if classify(v) != floatClass {
+		return 0
+	}
+	switch f := v.Float(); {
+	case math.IsInf(f, 1):
+		return 1
+	case math.IsInf(f, -1):
+		return -1
+	default:
+		return 0
+	}
+}
+
+func isNaNValue(v reflect.Value) bool {
+	return classify(v) == floatClass && math.IsNaN(v.Float())
+}
+
+func compareValues[T Ordered](a, b T) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// assertOrder compares the observed value with the 'want' argument and marks the function under
+// test as having failed if the result isn't accepted.
+func (a asserter) assertOrder(want interface{}, relation, description string, accept func(cmp int) bool) bool {
+	cmp, err := compareOrdered(a.got, want)
+	if err != nil {
+		a.errorf(fmt.Sprintf("Invalid argument: %v", err), want, true)
+		return false
+	}
+	if !accept(cmp) {
+		a.errorf(fmt.Sprintf("Observed value must be %s expected", description), want, true,
+			fmt.Sprintf("Expected relation: %v %s %v", a.got, relation, want))
+		return false
+	}
+	return true
+}
+
+// GreaterThan asserts the observed value is strictly greater than the 'want' argument. Integers,
+// unsigned integers, floats, json.Number values, strings, time.Duration and time.Time values are
+// supported. Numbers of different types are compared by value. If the observed value isn't
+// greater, the function under test is marked as having failed.
+//
+//	Example:
+//		assert(len(got)).GreaterThan(5)
+func (a asserter) GreaterThan(want interface{}) bool {
+	if a.navigationFailed {
+		return false
+	}
+	return a.assertOrder(want, ">", "greater than", func(cmp int) bool { return cmp > 0 })
+}
+
+// LessThan asserts the observed value is strictly less than the 'want' argument. See the
+// GreaterThan method for the supported types. If the observed value isn't less, the function
+// under test is marked as having failed.
+func (a asserter) LessThan(want interface{}) bool {
+	if a.navigationFailed {
+		return false
+	}
+	return a.assertOrder(want, "<", "less than", func(cmp int) bool { return cmp < 0 })
+}
+
+// AtLeast asserts the observed value is greater than or equal to the 'want' argument. See the
+// GreaterThan method for the supported types. If it's less, the function under test is marked as
+// having failed.
+func (a asserter) AtLeast(want interface{}) bool {
+	if a.navigationFailed {
+		return false
+	}
+	return a.assertOrder(want, ">=", "greater than or equal to", func(cmp int) bool { return cmp >= 0 })
+}
+
+// AtMost asserts the observed value is less than or equal to the 'want' argument. See the
+// GreaterThan method for the supported types. If it's greater, the function under test is marked
+// as having failed.
+func (a asserter) AtMost(want interface{}) bool {
+	if a.navigationFailed {
+		return false
+	}
+	return a.assertOrder(want, "<=", "less than or equal to", func(cmp int) bool { return cmp <= 0 })
+}
+
+// Between asserts the observed value is within the closed interval [low, high]. See the
+// GreaterThan method for the supported types. If it's not, the function under test is marked as
+// having failed.
+//
+//	Example:
+//		assert(elapsed).Between(time.Second, 2*time.Second)
+func (a asserter) Between(low, high interface{}) bool {
+	if a.navigationFailed {
+		return false
+	}
+	interval := fmt.Sprintf("[%v, %v]", low, high)
+	lowCmp, err := compareOrdered(a.got, low)
+	if err != nil {
+		a.errorf(fmt.Sprintf("Invalid argument: %v", err), interval, true)
+		return false
+	}
+	highCmp, err := compareOrdered(a.got, high)
+	if err != nil {
+		a.errorf(fmt.Sprintf("Invalid argument: %v", err), interval, true)
+		return false
+	}
+	if lowCmp < 0 || highCmp > 0 {
+		a.errorf("Observed value must be within the expected interval", interval, true,
+			fmt.Sprintf("Expected relation: %v <= %v <= %v", low, a.got, high))
+		return false
+	}
+	return true
+}
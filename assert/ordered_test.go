@@ -0,0 +1,365 @@
+package assert
+
+import (
+	"encoding/json"
+	"math"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestGreaterThan(t *testing.T) {
+	type args struct {
+		got  interface{}
+		want interface{}
+	}
+	tests := []struct {
+		name           string
+		args           args
+		want           bool
+		wantTestFailed bool
+		wantMessage    []string
+	}{
+		{
+			name:           "should pass when int greater than int",
+			args:           args{got: 5, want: 3},
+			want:           true,
+			wantTestFailed: false,
+		},
+		{
+			name:           "should fail when int equals int",
+			args:           args{got: 5, want: 5},
+			want:           false,
+			wantTestFailed: true,
+			wantMessage:    []string{"Observed value must be greater than expected", "Expected relation: 5 > 5"},
+		},
+		{
+			name:           "should pass when uint greater than negative int",
+			args:           args{got: uint(0), want: -1},
+			want:           true,
+			wantTestFailed: false,
+		},
+		{
+			name:           "should pass when int64 above 2^53 greater than float",
+			args:           args{got: int64(9007199254740993), want: 9007199254740992.0},
+			want:           true,
+			wantTestFailed: false,
+		},
+		{
+			name:           "should pass when json.Number above 2^53 greater than float",
+			args:           args{got: json.Number("9007199254740993"), want: 9007199254740992.0},
+			want:           true,
+			wantTestFailed: false,
+		},
+		{
+			name:           "should pass when json.Number beyond float64 range greater than max float64",
+			args:           args{got: json.Number("1e400"), want: math.MaxFloat64},
+			want:           true,
+			wantTestFailed: false,
+		},
+		{
+			name:           "should fail when invalid json.Number",
+			args:           args{got: json.Number("abc"), want: 1},
+			want:           false,
+			wantTestFailed: true,
+			wantMessage:    []string{"Invalid argument: values of type json.Number and int can't be ordered"},
+		},
+		{
+			name:           "should pass when duration greater than duration",
+			args:           args{got: 2 * time.Second, want: time.Second},
+			want:           true,
+			wantTestFailed: false,
+		},
+		{
+			name:           "should fail when time compared with duration",
+			args:           args{got: time.Date(2021, 1, 1, 12, 0, 0, 0, time.UTC), want: time.Second},
+			want:           false,
+			wantTestFailed: true,
+			wantMessage:    []string{"can't be compared with each other"},
+		},
+		{
+			name:           "should fail when get unordered type",
+			args:           args{got: nonZero["struct"], want: 1},
+			want:           false,
+			wantTestFailed: true,
+			wantMessage:    []string{"can't be ordered"},
+		},
+		{
+			name:           "should fail when get nil",
+			args:           args{got: nil, want: 1},
+			want:           false,
+			wantTestFailed: true,
+			wantMessage:    []string{"Invalid argument: nil values can't be ordered"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Given
+			assert := New(t)
+			dummyT := &fakeT{}
+			dummyAssert := New(dummyT)
+
+			// When
+			got := dummyAssert(tt.args.got).GreaterThan(tt.args.want)
+
+			// Then
+			assert(got).Equals(tt.want)
+			assert(dummyT.failed).Equals(tt.wantTestFailed)
+			for _, want := range tt.wantMessage {
+				assert(strings.Contains(strings.Join(dummyT.logs, "\n"), want)).IsTrue()
+			}
+		})
+	}
+}
+
+func TestLessThan(t *testing.T) {
+	now := time.Date(2021, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	type args struct {
+		got  interface{}
+		want interface{}
+	}
+	tests := []struct {
+		name           string
+		args           args
+		want           bool
+		wantTestFailed bool
+		wantMessage    []string
+	}{
+		{
+			name:           "should pass when int8 less than uint64",
+			args:           args{got: int8(-1), want: uint64(math.MaxUint64)},
+			want:           true,
+			wantTestFailed: false,
+		},
+		{
+			name:           "should pass when int less than infinity",
+			args:           args{got: math.MaxInt64, want: math.Inf(1)},
+			want:           true,
+			wantTestFailed: false,
+		},
+		{
+			name:           "should pass when json.Number beyond float64 range less than infinity",
+			args:           args{got: json.Number("1e400"), want: math.Inf(1)},
+			want:           true,
+			wantTestFailed: false,
+		},
+		{
+			name:           "should pass when string less than string",
+			args:           args{got: "abc", want: "abd"},
+			want:           true,
+			wantTestFailed: false,
+		},
+		{
+			name:           "should fail when string greater than string",
+			args:           args{got: "abd", want: "abc"},
+			want:           false,
+			wantTestFailed: true,
+			wantMessage:    []string{"Observed value must be less than expected", "Expected relation: abd < abc"},
+		},
+		{
+			name:           "should fail when string compared with int",
+			args:           args{got: "abc", want: 5},
+			want:           false,
+			wantTestFailed: true,
+			wantMessage:    []string{"Invalid argument: values of type string and int can't be compared with each other"},
+		},
+		{
+			name:           "should pass when time before later time",
+			args:           args{got: now, want: now.Add(time.Nanosecond)},
+			want:           true,
+			wantTestFailed: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Given
+			assert := New(t)
+			dummyT := &fakeT{}
+			dummyAssert := New(dummyT)
+
+			// When
+			got := dummyAssert(tt.args.got).LessThan(tt.args.want)
+
+			// Then
+			assert(got).Equals(tt.want)
+			assert(dummyT.failed).Equals(tt.wantTestFailed)
+			for _, want := range tt.wantMessage {
+				assert(strings.Contains(strings.Join(dummyT.logs, "\n"), want)).IsTrue()
+			}
+		})
+	}
+}
+
+func TestAtLeast(t *testing.T) {
+	now := time.Date(2021, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	type args struct {
+		got  interface{}
+		want interface{}
+	}
+	tests := []struct {
+		name           string
+		args           args
+		want           bool
+		wantTestFailed bool
+		wantMessage    []string
+	}{
+		{
+			name:           "should pass when float at least int",
+			args:           args{got: 3.0, want: 3},
+			want:           true,
+			wantTestFailed: false,
+		},
+		{
+			name:           "should fail when float less than uint64 above 2^53",
+			args:           args{got: 9007199254740992.0, want: uint64(9007199254740993)},
+			want:           false,
+			wantTestFailed: true,
+			wantMessage:    []string{"Observed value must be greater than or equal to expected"},
+		},
+		{
+			name:           "should pass when time at least same instant in other location",
+			args:           args{got: now.In(time.FixedZone("CET", 3600)), want: now},
+			want:           true,
+			wantTestFailed: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Given
+			assert := New(t)
+			dummyT := &fakeT{}
+			dummyAssert := New(dummyT)
+
+			// When
+			got := dummyAssert(tt.args.got).AtLeast(tt.args.want)
+
+			// Then
+			assert(got).Equals(tt.want)
+			assert(dummyT.failed).Equals(tt.wantTestFailed)
+			for _, want := range tt.wantMessage {
+				assert(strings.Contains(strings.Join(dummyT.logs, "\n"), want)).IsTrue()
+			}
+		})
+	}
+}
+
+func TestAtMost(t *testing.T) {
+	type args struct {
+		got  interface{}
+		want interface{}
+	}
+	tests := []struct {
+		name           string
+		args           args
+		want           bool
+		wantTestFailed bool
+		wantMessage    []string
+	}{
+		{
+			name:           "should pass when int equals int",
+			args:           args{got: 3, want: 3},
+			want:           true,
+			wantTestFailed: false,
+		},
+		{
+			name:           "should fail when float32 greater than float64",
+			args:           args{got: float32(3.5), want: 3.25},
+			want:           false,
+			wantTestFailed: true,
+			wantMessage:    []string{"Observed value must be less than or equal to expected", "Expected relation: 3.5 <= 3.25"},
+		},
+		{
+			name:           "should fail when get NaN",
+			args:           args{got: math.NaN(), want: 3.25},
+			want:           false,
+			wantTestFailed: true,
+			wantMessage:    []string{"Invalid argument: NaN can't be ordered"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Given
+			assert := New(t)
+			dummyT := &fakeT{}
+			dummyAssert := New(dummyT)
+
+			// When
+			got := dummyAssert(tt.args.got).AtMost(tt.args.want)
+
+			// Then
+			assert(got).Equals(tt.want)
+			assert(dummyT.failed).Equals(tt.wantTestFailed)
+			for _, want := range tt.wantMessage {
+				assert(strings.Contains(strings.Join(dummyT.logs, "\n"), want)).IsTrue()
+			}
+		})
+	}
+}
+
+func TestBetween(t *testing.T) {
+	type args struct {
+		got  interface{}
+		low  interface{}
+		high interface{}
+	}
+	tests := []struct {
+		name           string
+		args           args
+		want           bool
+		wantTestFailed bool
+		wantMessage    []string
+	}{
+		{
+			name:           "should pass when within interval",
+			args:           args{got: 1500 * time.Millisecond, low: time.Second, high: 2 * time.Second},
+			want:           true,
+			wantTestFailed: false,
+		},
+		{
+			name:           "should pass when at interval bound",
+			args:           args{got: 10, low: 1, high: 10},
+			want:           true,
+			wantTestFailed: false,
+		},
+		{
+			name:           "should fail when outside interval",
+			args:           args{got: 11, low: 1, high: 10},
+			want:           false,
+			wantTestFailed: true,
+			wantMessage:    []string{"Observed value must be within the expected interval", "Expected relation: 1 <= 11 <= 10"},
+		},
+		{
+			name:           "should pass when json.Number decimal between ints",
+			args:           args{got: json.Number("2.5"), low: 2, high: uint(3)},
+			want:           true,
+			wantTestFailed: false,
+		},
+		{
+			name:           "should fail when interval bound has other type",
+			args:           args{got: 5, low: 1, high: "10"},
+			want:           false,
+			wantTestFailed: true,
+			wantMessage:    []string{"Invalid argument"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Given
+			assert := New(t)
+			dummyT := &fakeT{}
+			dummyAssert := New(dummyT)
+
+			// When
+			got := dummyAssert(tt.args.got).Between(tt.args.low, tt.args.high)
+
+			// Then
+			assert(got).Equals(tt.want)
+			assert(dummyT.failed).Equals(tt.wantTestFailed)
+			for _, want := range tt.wantMessage {
+				assert(strings.Contains(strings.Join(dummyT.logs, "\n"), want)).IsTrue()
+			}
+		})
+	}
+}
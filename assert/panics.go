@@ -0,0 +1,135 @@
+package assert
+
+import (
+	"errors"
+	"fmt"
+	"runtime/debug"
+)
+
+// panicResult describes the outcome of calling a function that may panic.
+type panicResult struct {
+	panicked bool
+	value    interface{}
+	stack    string
+}
+
+// callAndRecover calls fn and recovers from any panic it raises.
+func callAndRecover(fn func()) (result panicResult) {
+	result.panicked = true
+	defer func() {
+		if result.panicked {
+			result.value = recover()
+			result.stack = string(debug.Stack())
+		}
+	}()
+	fn()
+	result.panicked = false
+	return result
+}
+
+func (r panicResult) details() []string {
+	return []string{
+		fmt.Sprintf("Panic value: %v", r.value),
+		"Panic stack:\n" + r.stack,
+	}
+}
+
+// observedFunc returns the observed value as a func(). If it's not, the function under test is
+// marked as having failed.
+func (a asserter) observedFunc(want interface{}, hasWant bool) (func(), bool) {
+	fn, ok := a.got.(func())
+	if !ok || fn == nil {
+		a.errorf("Observed value must be a non-nil func()", want, hasWant)
+		return nil, false
+	}
+	return fn, true
+}
+
+// Panics asserts the observed value is a func() that panics when called. If it doesn't, the
+// function under test is marked as having failed.
+//
+//	Example:
+//		assert(func() { MustParse("") }).Panics()
+func (a asserter) Panics() bool {
+	if a.navigationFailed {
+		return false
+	}
+	fn, ok := a.observedFunc(nil, false)
+	if !ok {
+		return false
+	}
+	if result := callAndRecover(fn); !result.panicked {
+		a.errorf("Observed function must panic", nil, false)
+		return false
+	}
+	return true
+}
+
+// PanicsWithValue asserts the observed value is a func() that panics with a value equal to the
+// 'want' argument when called. Values are compared like in the Equals method. If it doesn't, the
+// function under test is marked as having failed.
+func (a asserter) PanicsWithValue(want interface{}) bool {
+	if a.navigationFailed {
+		return false
+	}
+	fn, ok := a.observedFunc(want, true)
+	if !ok {
+		return false
+	}
+	result := callAndRecover(fn)
+	if !result.panicked {
+		a.errorf("Observed function must panic", want, true)
+		return false
+	}
+	if !equals(result.value, want) {
+		a.errorf("Observed function must panic with expected value", want, true, result.details()...)
+		return false
+	}
+	return true
+}
+
+// PanicsWithError asserts the observed value is a func() that panics with an error matching the
+// 'target' error according to errors.Is when called. If it doesn't, the function under test is
+// marked as having failed.
+func (a asserter) PanicsWithError(target error) bool {
+	if a.navigationFailed {
+		return false
+	}
+	fn, ok := a.observedFunc(target, true)
+	if !ok {
+		return false
+	}
+	result := callAndRecover(fn)
+	if !result.panicked {
+		a.errorf("Observed function must panic", target, true)
+		return false
+	}
+	err, isErr := result.value.(error)
+	if !isErr {
+		a.errorf("Observed function must panic with an error", target, true, result.details()...)
+		return false
+	}
+	if !errors.Is(err, target) {
+		details := append([]string{errorChainDetails(err)}, result.details()...)
+		a.errorf("Observed function must panic with an error matching expected error", target, true, details...)
+		return false
+	}
+	return true
+}
+
+// DoesNotPanic asserts the observed value is a func() that doesn't panic when called. If it does,
+// the function under test is marked as having failed.
+func (a asserter) DoesNotPanic() bool {
+	if a.navigationFailed {
+		return false
+	}
+	fn, ok := a.observedFunc(nil, false)
+	if !ok {
+		return false
+	}
+	if result := callAndRecover(fn); result.panicked {
+		a.errorf("Observed function must not panic", nil, false, result.details()...)
+		return false
+	}
+	return true
+}
@@ -0,0 +1,221 @@
+package assert
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+)
+
+func TestPanics(t *testing.T) {
+	type args struct {
+		got interface{}
+	}
+	tests := []struct {
+		name           string
+		args           args
+		want           bool
+		wantTestFailed bool
+		wantMessage    []string
+	}{
+		{
+			name:           "should pass when function panics",
+			args:           args{got: func() { panic("boom") }},
+			want:           true,
+			wantTestFailed: false,
+		},
+		{
+			name:           "should fail when function doesn't panic",
+			args:           args{got: func() {}},
+			want:           false,
+			wantTestFailed: true,
+			wantMessage:    []string{"Observed function must panic"},
+		},
+		{
+			name:           "should fail when get non-function",
+			args:           args{got: nonZero["int"]},
+			want:           false,
+			wantTestFailed: true,
+			wantMessage:    []string{"Observed value must be a non-nil func()"},
+		},
+		{
+			name:           "should fail when get nil function",
+			args:           args{got: (func())(nil)},
+			want:           false,
+			wantTestFailed: true,
+			wantMessage:    []string{"Observed value must be a non-nil func()"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Given
+			assert := New(t)
+			dummyT := &fakeT{}
+			dummyAssert := New(dummyT)
+
+			// When
+			got := dummyAssert(tt.args.got).Panics()
+
+			// Then
+			assert(got).Equals(tt.want)
+			assert(dummyT.failed).Equals(tt.wantTestFailed)
+			for _, want := range tt.wantMessage {
+				assert(strings.Contains(strings.Join(dummyT.logs, "\n"), want)).IsTrue()
+			}
+		})
+	}
+}
+
+func TestPanicsWithValue(t *testing.T) {
+	type args struct {
+		got  interface{}
+		want interface{}
+	}
+	tests := []struct {
+		name           string
+		args           args
+		want           bool
+		wantTestFailed bool
+		wantMessage    []string
+	}{
+		{
+			name:           "should pass when function panics with wanted value",
+			args:           args{got: func() { panic("boom") }, want: "boom"},
+			want:           true,
+			wantTestFailed: false,
+		},
+		{
+			name:           "should fail when function panics with other value",
+			args:           args{got: func() { panic("boom") }, want: "bang"},
+			want:           false,
+			wantTestFailed: true,
+			wantMessage:    []string{"Observed function must panic with expected value", "Panic value: boom"},
+		},
+		{
+			name:           "should fail when function doesn't panic",
+			args:           args{got: func() {}, want: "boom"},
+			want:           false,
+			wantTestFailed: true,
+			wantMessage:    []string{"Observed function must panic"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Given
+			assert := New(t)
+			dummyT := &fakeT{}
+			dummyAssert := New(dummyT)
+
+			// When
+			got := dummyAssert(tt.args.got).PanicsWithValue(tt.args.want)
+
+			// Then
+			assert(got).Equals(tt.want)
+			assert(dummyT.failed).Equals(tt.wantTestFailed)
+			for _, want := range tt.wantMessage {
+				assert(strings.Contains(strings.Join(dummyT.logs, "\n"), want)).IsTrue()
+			}
+		})
+	}
+}
+
+func TestPanicsWithError(t *testing.T) {
+	panicsWithError := func() { panic(fmt.Errorf("wrapped: %w", io.EOF)) }
+
+	type args struct {
+		got    interface{}
+		target error
+	}
+	tests := []struct {
+		name           string
+		args           args
+		want           bool
+		wantTestFailed bool
+		wantMessage    []string
+	}{
+		{
+			name:           "should pass when function panics with error wrapping target",
+			args:           args{got: panicsWithError, target: io.EOF},
+			want:           true,
+			wantTestFailed: false,
+		},
+		{
+			name:           "should fail when function panics with error not matching target",
+			args:           args{got: panicsWithError, target: errors.New("other")},
+			want:           false,
+			wantTestFailed: true,
+			wantMessage:    []string{"Observed function must panic with an error matching expected error"},
+		},
+		{
+			name:           "should fail when function panics with non-error",
+			args:           args{got: func() { panic("boom") }, target: io.EOF},
+			want:           false,
+			wantTestFailed: true,
+			wantMessage:    []string{"Observed function must panic with an error", "Panic value: boom"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Given
+			assert := New(t)
+			dummyT := &fakeT{}
+			dummyAssert := New(dummyT)
+
+			// When
+			got := dummyAssert(tt.args.got).PanicsWithError(tt.args.target)
+
+			// Then
+			assert(got).Equals(tt.want)
+			assert(dummyT.failed).Equals(tt.wantTestFailed)
+			for _, want := range tt.wantMessage {
+				assert(strings.Contains(strings.Join(dummyT.logs, "\n"), want)).IsTrue()
+			}
+		})
+	}
+}
+
+func TestDoesNotPanic(t *testing.T) {
+	type args struct {
+		got interface{}
+	}
+	tests := []struct {
+		name           string
+		args           args
+		want           bool
+		wantTestFailed bool
+		wantMessage    []string
+	}{
+		{
+			name:           "should pass when function doesn't panic",
+			args:           args{got: func() {}},
+			want:           true,
+			wantTestFailed: false,
+		},
+		{
+			name:           "should fail and report stack when function panics",
+			args:           args{got: func() { panic("boom") }},
+			want:           false,
+			wantTestFailed: true,
+			wantMessage:    []string{"Observed function must not panic", "Panic value: boom", "TestDoesNotPanic"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Given
+			assert := New(t)
+			dummyT := &fakeT{}
+			dummyAssert := New(dummyT)
+
+			// When
+			got := dummyAssert(tt.args.got).DoesNotPanic()
+
+			// Then
+			assert(got).Equals(tt.want)
+			assert(dummyT.failed).Equals(tt.wantTestFailed)
+			for _, want := range tt.wantMessage {
+				assert(strings.Contains(strings.Join(dummyT.logs, "\n"), want)).IsTrue()
+			}
+		})
+	}
+}
@@ -0,0 +1,251 @@
+package assert
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	"time"
+)
+
+const (
+	defaultPollTimeout  = time.Second
+	defaultPollInterval = 10 * time.Millisecond
+)
+
+// PollOption configures how often and for how long polling assertions evaluate the observed
+// function.
+type PollOption func(*pollConfig)
+
+type pollConfig struct {
+	timeout       time.Duration
+	interval      time.Duration
+	backoffFactor float64
+	maxInterval   time.Duration
+	err           error
+}
+
+func newPollConfig(opts []PollOption) (pollConfig, error) {
+	cfg := pollConfig{
+		timeout:       defaultPollTimeout,
+		interval:      defaultPollInterval,
+		backoffFactor: 1,
+	}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	return cfg, cfg.err
+}
+
+// WithTimeout sets for how long the observed function is polled. Defaults to one second.
+func WithTimeout(timeout time.Duration) PollOption {
+	return func(cfg *pollConfig) {
+		if timeout <= 0 {
+			cfg.err = fmt.Errorf("timeout must be positive, got %v", timeout)
+			return
+		}
+		cfg.timeout = timeout
+	}
+}
+
+// WithInterval sets the time to wait between evaluations of the observed function. Defaults to
+// ten milliseconds.
+func WithInterval(interval time.Duration) PollOption {
+	return func(cfg *pollConfig) {
+		if interval <= 0 {
+			cfg.err = fmt.Errorf("interval must be positive, got %v", interval)
+			return
+		}
+		cfg.interval = interval
+	}
+}
+
+// WithBackoff multiplies the interval by 'factor' after every evaluation of the observed function,
+// up to 'maxInterval'. The factor must be finite and at least 1. A maxInterval of zero means the
+// interval isn't capped.
+func WithBackoff(factor float64, maxInterval time.Duration) PollOption {
+	return func(cfg *pollConfig) {
+		if !(factor >= 1) || math.IsInf(factor, 1) || maxInterval < 0 {
+			cfg.err = fmt.Errorf("backoff factor must be finite and at least 1 and max interval non-negative, got %v and %v",
+				factor, maxInterval)
+			return
+		}
+		cfg.backoffFactor = factor
+		cfg.maxInterval = maxInterval
+	}
+}
+
+// pollResult describes the outcome of polling.
+type pollResult struct {
+	// stopped is true if polling stopped because the stop condition was met before the timeout
+	stopped   bool
+	lastValue interface{}
+	attempts  int
+	elapsed   time.Duration
+}
+
+func (r pollResult) details() []string {
+	return []string{
+		fmt.Sprintf("Last observed value: %v", r.lastValue),
+		fmt.Sprintf("Attempts: %d", r.attempts),
+		fmt.Sprintf("Elapsed: %v", r.elapsed),
+	}
+}
+
+// poll calls fn until stop returns true for the value it returned, or until the timeout expires.
+// The function is always called at least once.
+func poll(cfg pollConfig, fn func() interface{}, stop func(value interface{}) bool) pollResult {
+	var result pollResult
+	start := time.Now()
+	deadline := start.Add(cfg.timeout)
+	interval := cfg.interval
+	for {
+		result.lastValue = fn()
+		result.attempts++
+		if stop(result.lastValue) {
+			result.stopped = true
+			break
+		}
+		remaining := time.Until(deadline)
+		if remaining <= 0 {
+			break
+		}
+		if interval < remaining {
+			remaining = interval
+		}
+		time.Sleep(remaining)
+		// The interval is kept from overflowing, in which case it would become negative
+		if next := float64(interval) * cfg.backoffFactor; next < math.MaxInt64 {
+			interval = time.Duration(next)
+		} else {
+			interval = math.MaxInt64
+		}
+		if cfg.maxInterval > 0 && interval > cfg.maxInterval {
+			interval = cfg.maxInterval
+		}
+	}
+	result.elapsed = time.Since(start)
+	return result
+}
+
+// observedCondition returns the observed value as a func() bool, along with the poll
+// configuration. If either is invalid, the function under test is marked as having failed.
+func (a asserter) observedCondition(opts []PollOption) (func() interface{}, pollConfig, bool) {
+	cfg, err := newPollConfig(opts)
+	if err != nil {
+		a.errorf(fmt.Sprintf("Invalid argument: %v", err), nil, false)
+		return nil, cfg, false
+	}
+	condition, ok := a.got.(func() bool)
+	if !ok || condition == nil {
+		a.errorf("Observed value must be a non-nil func() bool", nil, false)
+		return nil, cfg, false
+	}
+	return func() interface{} { return condition() }, cfg, true
+}
+
+// Eventually asserts the observed value is a func() bool that returns true within the timeout.
+// The function is polled until it returns true or the timeout expires. If it never returns
+// true, the function under test is marked as having failed.
+//
+//	Example:
+//		assert(func() bool { return worker.Done() }).Eventually(assert.WithTimeout(5 * time.Second))
+func (a asserter) Eventually(opts ...PollOption) bool {
+	if a.navigationFailed {
+		return false
+	}
+	fn, cfg, ok := a.observedCondition(opts)
+	if !ok {
+		return false
+	}
+	result := poll(cfg, fn, isTrue)
+	if !result.stopped {
+		a.errorf(fmt.Sprintf("Observed condition must become true within %v", cfg.timeout), nil, false,
+			result.details()...)
+		return false
+	}
+	return true
+}
+
+// Never asserts the observed value is a func() bool that doesn't return true within the timeout.
+// The function is polled until the timeout expires. If it returns true, the function under test
+// is marked as having failed.
+func (a asserter) Never(opts ...PollOption) bool {
+	if a.navigationFailed {
+		return false
+	}
+	fn, cfg, ok := a.observedCondition(opts)
+	if !ok {
+		return false
+	}
+	result := poll(cfg, fn, isTrue)
+	if result.stopped {
+		a.errorf(fmt.Sprintf("Observed condition must not become true within %v", cfg.timeout), nil, false,
+			result.details()...)
+		return false
+	}
+	return true
+}
+
+// Consistently asserts the observed value is a func() bool that keeps returning true for the
+// duration of the timeout. The function is polled until the timeout expires. If it returns false,
+// the function under test is marked as having failed.
+func (a asserter) Consistently(opts ...PollOption) bool {
+	if a.navigationFailed {
+		return false
+	}
+	fn, cfg, ok := a.observedCondition(opts)
+	if !ok {
+		return false
+	}
+	result := poll(cfg, fn, isFalse)
+	if result.stopped {
+		a.errorf(fmt.Sprintf("Observed condition must stay true for %v", cfg.timeout), nil, false,
+			result.details()...)
+		return false
+	}
+	return true
+}
+
+// EventuallyEquals asserts the observed value is a function without parameters returning a single
+// value, which returns a value equal to the 'want' argument within the timeout. Values are
+// compared like in the Equals method. The function is polled until it returns the wanted value
+// or the timeout expires. If it never does, the function under test is marked as having failed.
+//
+//	Example:
+//		assert(func() int { return queue.Len() }).EventuallyEquals(0)
+func (a asserter) EventuallyEquals(want interface{}, opts ...PollOption) bool {
+	if a.navigationFailed {
+		return false
+	}
+	cfg, err := newPollConfig(opts)
+	if err == nil {
+		err = validateValueFunc(a.got)
+	}
+	if err != nil {
+		a.errorf(fmt.Sprintf("Invalid argument: %v", err), want, true)
+		return false
+	}
+	fn := reflect.ValueOf(a.got)
+	result := poll(cfg,
+		func() interface{} { return fn.Call(nil)[0].Interface() },
+		func(value interface{}) bool { return equals(value, want) },
+	)
+	if !result.stopped {
+		a.errorf(fmt.Sprintf("Observed function must return expected value within %v", cfg.timeout), want, true,
+			result.details()...)
+		return false
+	}
+	return true
+}
+
+func validateValueFunc(fn interface{}) error {
+	if !isFunc(fn) || isNil(fn) {
+		return errors.New("observed value must be a non-nil function")
+	}
+	fnType := reflect.TypeOf(fn)
+	if fnType.NumIn() != 0 || fnType.NumOut() != 1 {
+		return fmt.Errorf("observed function must have no parameters and return a single value, got %s", fnType)
+	}
+	return nil
+}
@@ -0,0 +1,277 @@
+package assert
+
+import (
+	"math"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+var pollingTestShort = []PollOption{WithTimeout(50 * time.Millisecond), WithInterval(time.Millisecond)}
+
+// pollingTestAfterCalls returns a condition that becomes true after it's been evaluated n times.
+func pollingTestAfterCalls(n int32) func() bool {
+	var calls int32
+	return func() bool { return atomic.AddInt32(&calls, 1) > n }
+}
+
+func TestEventually(t *testing.T) {
+	type args struct {
+		got  interface{}
+		opts []PollOption
+	}
+	tests := []struct {
+		name           string
+		args           args
+		want           bool
+		wantTestFailed bool
+		wantMessage    []string
+	}{
+		{
+			name:           "should pass when condition eventually becomes true",
+			args:           args{got: pollingTestAfterCalls(3), opts: pollingTestShort},
+			want:           true,
+			wantTestFailed: false,
+		},
+		{
+			name:           "should fail when condition never becomes true",
+			args:           args{got: func() bool { return false }, opts: pollingTestShort},
+			want:           false,
+			wantTestFailed: true,
+			wantMessage:    []string{"Observed condition must become true within 50ms", "Attempts: "},
+		},
+		{
+			name:           "should fail when get non-condition",
+			args:           args{got: nonZero["int"], opts: pollingTestShort},
+			want:           false,
+			wantTestFailed: true,
+			wantMessage:    []string{"Observed value must be a non-nil func() bool"},
+		},
+		{
+			name:           "should fail when timeout is invalid",
+			args:           args{got: func() bool { return true }, opts: []PollOption{WithTimeout(0)}},
+			want:           false,
+			wantTestFailed: true,
+			wantMessage:    []string{"Invalid argument: timeout must be positive, got 0s"},
+		},
+		{
+			name: "should pass when condition eventually becomes true with backoff",
+			args: args{
+				got:  pollingTestAfterCalls(3),
+				opts: append([]PollOption{WithBackoff(2, 4*time.Millisecond)}, pollingTestShort...),
+			},
+			want:           true,
+			wantTestFailed: false,
+		},
+		{
+			name:           "should fail when backoff factor is less than 1",
+			args:           args{got: func() bool { return true }, opts: []PollOption{WithBackoff(0.5, 0)}},
+			want:           false,
+			wantTestFailed: true,
+			wantMessage:    []string{"Invalid argument: backoff factor must be finite and at least 1"},
+		},
+		{
+			name:           "should fail when backoff factor is NaN",
+			args:           args{got: func() bool { return true }, opts: []PollOption{WithBackoff(math.NaN(), 0)}},
+			want:           false,
+			wantTestFailed: true,
+			wantMessage:    []string{"Invalid argument: backoff factor must be finite and at least 1"},
+		},
+		{
+			name:           "should fail when backoff factor is infinite",
+			args:           args{got: func() bool { return true }, opts: []PollOption{WithBackoff(math.Inf(1), 0)}},
+			want:           false,
+			wantTestFailed: true,
+			wantMessage:    []string{"Invalid argument: backoff factor must be finite and at least 1"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Given
+			assert := New(t)
+			dummyT := &fakeT{}
+			dummyAssert := New(dummyT)
+
+			// When
+			got := dummyAssert(tt.args.got).Eventually(tt.args.opts...)
+
+			// Then
+			assert(got).Equals(tt.want)
+			assert(dummyT.failed).Equals(tt.wantTestFailed)
+			for _, want := range tt.wantMessage {
+				assert(strings.Contains(strings.Join(dummyT.logs, "\n"), want)).IsTrue()
+			}
+		})
+	}
+}
+
+func TestNever(t *testing.T) {
+	type args struct {
+		got  interface{}
+		opts []PollOption
+	}
+	tests := []struct {
+		name           string
+		args           args
+		want           bool
+		wantTestFailed bool
+		wantMessage    []string
+	}{
+		{
+			name:           "should pass when condition never becomes true",
+			args:           args{got: func() bool { return false }, opts: pollingTestShort},
+			want:           true,
+			wantTestFailed: false,
+		},
+		{
+			name:           "should fail when condition becomes true",
+			args:           args{got: pollingTestAfterCalls(3), opts: pollingTestShort},
+			want:           false,
+			wantTestFailed: true,
+			wantMessage:    []string{"Observed condition must not become true within 50ms", "Attempts: 4"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Given
+			assert := New(t)
+			dummyT := &fakeT{}
+			dummyAssert := New(dummyT)
+
+			// When
+			got := dummyAssert(tt.args.got).Never(tt.args.opts...)
+
+			// Then
+			assert(got).Equals(tt.want)
+			assert(dummyT.failed).Equals(tt.wantTestFailed)
+			for _, want := range tt.wantMessage {
+				assert(strings.Contains(strings.Join(dummyT.logs, "\n"), want)).IsTrue()
+			}
+		})
+	}
+}
+
+func TestConsistently(t *testing.T) {
+	becomesTrue := pollingTestAfterCalls(3)
+
+	type args struct {
+		got  interface{}
+		opts []PollOption
+	}
+	tests := []struct {
+		name           string
+		args           args
+		want           bool
+		wantTestFailed bool
+		wantMessage    []string
+	}{
+		{
+			name:           "should pass when condition stays true",
+			args:           args{got: func() bool { return true }, opts: pollingTestShort},
+			want:           true,
+			wantTestFailed: false,
+		},
+		{
+			name:           "should fail when condition doesn't stay true",
+			args:           args{got: func() bool { return !becomesTrue() }, opts: pollingTestShort},
+			want:           false,
+			wantTestFailed: true,
+			wantMessage:    []string{"Observed condition must stay true for 50ms", "Attempts: 4"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Given
+			assert := New(t)
+			dummyT := &fakeT{}
+			dummyAssert := New(dummyT)
+
+			// When
+			got := dummyAssert(tt.args.got).Consistently(tt.args.opts...)
+
+			// Then
+			assert(got).Equals(tt.want)
+			assert(dummyT.failed).Equals(tt.wantTestFailed)
+			for _, want := range tt.wantMessage {
+				assert(strings.Contains(strings.Join(dummyT.logs, "\n"), want)).IsTrue()
+			}
+		})
+	}
+}
+
+func TestEventuallyEquals(t *testing.T) {
+	var calls int32
+
+	type args struct {
+		got  interface{}
+		want interface{}
+		opts []PollOption
+	}
+	tests := []struct {
+		name           string
+		args           args
+		want           bool
+		wantTestFailed bool
+		wantMessage    []string
+	}{
+		{
+			name: "should pass when function eventually returns wanted value",
+			args: args{
+				got:  func() int { return int(atomic.AddInt32(&calls, 1)) },
+				want: 3,
+				opts: pollingTestShort,
+			},
+			want:           true,
+			wantTestFailed: false,
+		},
+		{
+			name:           "should fail when function never returns wanted value",
+			args:           args{got: func() string { return "pending" }, want: "done", opts: pollingTestShort},
+			want:           false,
+			wantTestFailed: true,
+			wantMessage: []string{
+				"Observed function must return expected value within 50ms",
+				"Last observed value: pending",
+				"Attempts: ",
+			},
+		},
+		{
+			name:           "should fail when function has parameters",
+			args:           args{got: func(i int) int { return i }, want: 1, opts: pollingTestShort},
+			want:           false,
+			wantTestFailed: true,
+			wantMessage:    []string{"Invalid argument: observed function must have no parameters and return a single value"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Given
+			assert := New(t)
+			dummyT := &fakeT{}
+			dummyAssert := New(dummyT)
+
+			// When
+			got := dummyAssert(tt.args.got).EventuallyEquals(tt.args.want, tt.args.opts...)
+
+			// Then
+			assert(got).Equals(tt.want)
+			assert(dummyT.failed).Equals(tt.wantTestFailed)
+			for _, want := range tt.wantMessage {
+				assert(strings.Contains(strings.Join(dummyT.logs, "\n"), want)).IsTrue()
+			}
+		})
+	}
+}
+
+func TestPollDoesNotOverflowInterval(t *testing.T) {
+	// Given
+	assert := New(t)
+	cfg := pollConfig{timeout: 30 * time.Millisecond, interval: time.Millisecond, backoffFactor: 1e300}
+
+	// When
+	result := poll(cfg, func() interface{} { return false }, func(value interface{}) bool { return false })
+
+	// Then
+	assert(result.attempts).AtMost(3)
+}
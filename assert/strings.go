@@ -0,0 +1,219 @@
+package assert
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// toText returns the value as a string. Strings, byte slices and fmt.Stringer values, including
+// named types based on strings and byte slices, are supported.
+func toText(v interface{}) (string, bool) {
+	switch text := v.(type) {
+	case string:
+		return text, true
+	case []byte:
+		return string(text), true
+	case fmt.Stringer:
+		if isNil(text) {
+			return "", false
+		}
+		return text.String(), true
+	}
+
+	if v == nil {
+		return "", false
+	}
+	value := reflect.ValueOf(v)
+	switch {
+	case value.Kind() == reflect.String:
+		return value.String(), true
+	case value.Kind() == reflect.Slice && value.Type().Elem().Kind() == reflect.Uint8:
+		return string(value.Bytes()), true
+	default:
+		return "", false
+	}
+}
+
+// observedText returns the observed value as a string. If it's not a string, byte slice or
+// fmt.Stringer, the function under test is marked as having failed.
+func (a asserter) observedText(want interface{}) (string, bool) {
+	text, ok := toText(a.got)
+	if !ok {
+		a.errorf("Observed value must be a string, []byte or fmt.Stringer", want, true)
+	}
+	return text, ok
+}
+
+// lines splits the text into lines. Both \n and \r\n line endings are supported, and a final line
+// ending doesn't start a new line.
+func lines(text string) []string {
+	if text == "" {
+		return nil
+	}
+	split := strings.Split(strings.TrimSuffix(text, "\n"), "\n")
+	for i, line := range split {
+		split[i] = strings.TrimSuffix(line, "\r")
+	}
+	return split
+}
+
+// HasPrefix asserts the observed value is a string, []byte or fmt.Stringer beginning with the
+// 'want' argument. If not, the function under test is marked as having failed.
+func (a asserter) HasPrefix(want string) bool {
+	if a.navigationFailed {
+		return false
+	}
+	text, ok := a.observedText(want)
+	if !ok {
+		return false
+	}
+	if !strings.HasPrefix(text, want) {
+		a.errorf("Observed value must begin with expected prefix", want, true)
+		return false
+	}
+	return true
+}
+
+// HasSuffix asserts the observed value is a string, []byte or fmt.Stringer ending with the 'want'
+// argument. If not, the function under test is marked as having failed.
+func (a asserter) HasSuffix(want string) bool {
+	if a.navigationFailed {
+		return false
+	}
+	text, ok := a.observedText(want)
+	if !ok {
+		return false
+	}
+	if !strings.HasSuffix(text, want) {
+		a.errorf("Observed value must end with expected suffix", want, true)
+		return false
+	}
+	return true
+}
+
+// EqualFold asserts the observed value is a string, []byte or fmt.Stringer that's equal to the
+// 'want' argument under Unicode case-folding. If not, the function under test is marked as having
+// failed.
+func (a asserter) EqualFold(want string) bool {
+	if a.navigationFailed {
+		return false
+	}
+	text, ok := a.observedText(want)
+	if !ok {
+		return false
+	}
+	if !strings.EqualFold(text, want) {
+		a.errorf("Observed value must equal expected value, ignoring case", want, true)
+		return false
+	}
+	return true
+}
+
+// MatchesRegexp asserts the observed value is a string, []byte or fmt.Stringer that matches the
+// regular expression 'pattern', which must be either a string or a *regexp.Regexp. If not, the
+// function under test is marked as having failed.
+//
+//	Example:
+//		assert(got).MatchesRegexp(`^order-\d+$`)
+func (a asserter) MatchesRegexp(pattern interface{}) bool {
+	if a.navigationFailed {
+		return false
+	}
+	return a.MatchesRegexpWithGroups(pattern, nil)
+}
+
+// MatchesRegexpWithGroups asserts the observed value is a string, []byte or fmt.Stringer that
+// matches the regular expression 'pattern', which must be either a string or a *regexp.Regexp,
+// and that the named capture groups of the leftmost match have the wanted values. If not, the
+// function under test is marked as having failed.
+//
+//	Example:
+//		assert(got).MatchesRegexpWithGroups(`^(?P<user>\w+)@(?P<host>[\w.]+)$`, map[string]string{
+//			"host": "example.com",
+//		})
+func (a asserter) MatchesRegexpWithGroups(pattern interface{}, wantGroups map[string]string) bool {
+	if a.navigationFailed {
+		return false
+	}
+	re, err := toRegexp(pattern)
+	if err == nil {
+		for name := range wantGroups {
+			if re.SubexpIndex(name) < 0 {
+				err = fmt.Errorf("pattern has no capture group named %q", name)
+				break
+			}
+		}
+	}
+	if err != nil {
+		a.errorf(fmt.Sprintf("Invalid argument: %v", err), pattern, true)
+		return false
+	}
+	text, ok := a.observedText(re)
+	if !ok {
+		return false
+	}
+
+	match := re.FindStringSubmatch(text)
+	if match == nil {
+		a.errorf("Observed value must match expected pattern", re, true)
+		return false
+	}
+
+	var details []string
+	names := make([]string, 0, len(wantGroups))
+	for name := range wantGroups {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if got := match[re.SubexpIndex(name)]; got != wantGroups[name] {
+			details = append(details, fmt.Sprintf("Group %s: want %q, got %q", name, wantGroups[name], got))
+		}
+	}
+	if len(details) > 0 {
+		a.errorf("Observed value's capture groups must have expected values", re, true, details...)
+		return false
+	}
+	return true
+}
+
+// ContainsLine asserts the observed value is a string, []byte or fmt.Stringer with a line equal
+// to the 'want' argument. Both \n and \r\n line endings are supported. If not, the function under
+// test is marked as having failed.
+func (a asserter) ContainsLine(want string) bool {
+	if a.navigationFailed {
+		return false
+	}
+	text, ok := a.observedText(want)
+	if !ok {
+		return false
+	}
+	for _, line := range lines(text) {
+		if line == want {
+			return true
+		}
+	}
+	a.errorf("Observed value must contain expected line", want, true)
+	return false
+}
+
+// HasLineCount asserts the observed value is a string, []byte or fmt.Stringer with 'want' lines.
+// A final line ending doesn't start a new line, and an empty string has no lines. If the line
+// count differs, the function under test is marked as having failed.
+func (a asserter) HasLineCount(want int) bool {
+	if a.navigationFailed {
+		return false
+	}
+	text, ok := a.observedText(want)
+	if !ok {
+		return false
+	}
+	if got := len(lines(text)); got != want {
+		a.errorf("Observed value must have expected number of lines", want, true,
+			fmt.Sprintf("Line count: want %d, got %d", want, got))
+		return false
+	}
+	return true
+}
@@ -0,0 +1,456 @@
+package assert
+
+import (
+	"net"
+	"regexp"
+	"strings"
+	"testing"
+)
+
+func TestContainsWithText(t *testing.T) {
+	type args struct {
+		got  interface{}
+		want interface{}
+	}
+	tests := []struct {
+		name           string
+		args           args
+		want           bool
+		wantTestFailed bool
+	}{
+		{
+			name:           "should pass when string contains substring",
+			args:           args{got: "hello world", want: "lo wo"},
+			want:           true,
+			wantTestFailed: false,
+		},
+		{
+			name:           "should fail when string doesn't contain substring",
+			args:           args{got: "hello world", want: "bye"},
+			want:           false,
+			wantTestFailed: true,
+		},
+		{
+			name:           "should pass when bytes contain bytes",
+			args:           args{got: []byte("hello world"), want: []byte("world")},
+			want:           true,
+			wantTestFailed: false,
+		},
+		{
+			name:           "should pass when stringer contains substring",
+			args:           args{got: net.IPv4(10, 0, 0, 1), want: "10.0"},
+			want:           true,
+			wantTestFailed: false,
+		},
+		{
+			name:           "should fail when get non-text",
+			args:           args{got: nonZero["struct"], want: "a"},
+			want:           false,
+			wantTestFailed: true,
+		},
+		{
+			name:           "should fail when get nil",
+			args:           args{got: nil, want: "a"},
+			want:           false,
+			wantTestFailed: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Given
+			assert := New(t)
+			dummyT := &fakeT{}
+			dummyAssert := New(dummyT)
+
+			// When
+			got := dummyAssert(tt.args.got).Contains(tt.args.want)
+
+			// Then
+			assert(got).Equals(tt.want)
+			assert(dummyT.failed).Equals(tt.wantTestFailed)
+		})
+	}
+}
+
+func TestNotContainsWithText(t *testing.T) {
+	type args struct {
+		got  interface{}
+		want interface{}
+	}
+	tests := []struct {
+		name           string
+		args           args
+		want           bool
+		wantTestFailed bool
+	}{
+		{
+			name:           "should pass when string doesn't contain unwanted substring",
+			args:           args{got: "hello world", want: "bye"},
+			want:           true,
+			wantTestFailed: false,
+		},
+		{
+			name:           "should fail when string contains unwanted substring",
+			args:           args{got: "hello world", want: "world"},
+			want:           false,
+			wantTestFailed: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Given
+			assert := New(t)
+			dummyT := &fakeT{}
+			dummyAssert := New(dummyT)
+
+			// When
+			got := dummyAssert(tt.args.got).NotContains(tt.args.want)
+
+			// Then
+			assert(got).Equals(tt.want)
+			assert(dummyT.failed).Equals(tt.wantTestFailed)
+		})
+	}
+}
+
+func TestHasPrefix(t *testing.T) {
+	type name string
+	type args struct {
+		got  interface{}
+		want string
+	}
+	tests := []struct {
+		name           string
+		args           args
+		want           bool
+		wantTestFailed bool
+	}{
+		{
+			name:           "should pass when named string has prefix",
+			args:           args{got: name("hello world"), want: "hello"},
+			want:           true,
+			wantTestFailed: false,
+		},
+		{
+			name:           "should fail when string doesn't have prefix",
+			args:           args{got: "hello world", want: "world"},
+			want:           false,
+			wantTestFailed: true,
+		},
+		{
+			name:           "should fail when get non-text",
+			args:           args{got: nonZero["int"], want: "3"},
+			want:           false,
+			wantTestFailed: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Given
+			assert := New(t)
+			dummyT := &fakeT{}
+			dummyAssert := New(dummyT)
+
+			// When
+			got := dummyAssert(tt.args.got).HasPrefix(tt.args.want)
+
+			// Then
+			assert(got).Equals(tt.want)
+			assert(dummyT.failed).Equals(tt.wantTestFailed)
+		})
+	}
+}
+
+func TestHasSuffix(t *testing.T) {
+	type args struct {
+		got  interface{}
+		want string
+	}
+	tests := []struct {
+		name           string
+		args           args
+		want           bool
+		wantTestFailed bool
+	}{
+		{
+			name:           "should pass when string has suffix",
+			args:           args{got: "hello world", want: "world"},
+			want:           true,
+			wantTestFailed: false,
+		},
+		{
+			name:           "should fail when string doesn't have suffix",
+			args:           args{got: "hello world", want: "hello"},
+			want:           false,
+			wantTestFailed: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Given
+			assert := New(t)
+			dummyT := &fakeT{}
+			dummyAssert := New(dummyT)
+
+			// When
+			got := dummyAssert(tt.args.got).HasSuffix(tt.args.want)
+
+			// Then
+			assert(got).Equals(tt.want)
+			assert(dummyT.failed).Equals(tt.wantTestFailed)
+		})
+	}
+}
+
+func TestEqualFold(t *testing.T) {
+	type args struct {
+		got  interface{}
+		want string
+	}
+	tests := []struct {
+		name           string
+		args           args
+		want           bool
+		wantTestFailed bool
+	}{
+		{
+			name:           "should pass when string equal ignoring case",
+			args:           args{got: "Hello World", want: "hello WORLD"},
+			want:           true,
+			wantTestFailed: false,
+		},
+		{
+			name:           "should fail when string unequal ignoring case",
+			args:           args{got: "Hello World", want: "hello"},
+			want:           false,
+			wantTestFailed: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Given
+			assert := New(t)
+			dummyT := &fakeT{}
+			dummyAssert := New(dummyT)
+
+			// When
+			got := dummyAssert(tt.args.got).EqualFold(tt.args.want)
+
+			// Then
+			assert(got).Equals(tt.want)
+			assert(dummyT.failed).Equals(tt.wantTestFailed)
+		})
+	}
+}
+
+func TestMatchesRegexp(t *testing.T) {
+	type args struct {
+		got     interface{}
+		pattern interface{}
+	}
+	tests := []struct {
+		name           string
+		args           args
+		want           bool
+		wantTestFailed bool
+		wantMessage    []string
+	}{
+		{
+			name:           "should pass when string matches pattern",
+			args:           args{got: "order-123", pattern: `^order-\d+$`},
+			want:           true,
+			wantTestFailed: false,
+		},
+		{
+			name:           "should fail when string doesn't match compiled pattern",
+			args:           args{got: "order-abc", pattern: regexp.MustCompile(`^order-\d+$`)},
+			want:           false,
+			wantTestFailed: true,
+			wantMessage:    []string{"Observed value must match expected pattern"},
+		},
+		{
+			name:           "should fail when pattern is invalid",
+			args:           args{got: "order-123", pattern: `[`},
+			want:           false,
+			wantTestFailed: true,
+			wantMessage:    []string{"Invalid argument"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Given
+			assert := New(t)
+			dummyT := &fakeT{}
+			dummyAssert := New(dummyT)
+
+			// When
+			got := dummyAssert(tt.args.got).MatchesRegexp(tt.args.pattern)
+
+			// Then
+			assert(got).Equals(tt.want)
+			assert(dummyT.failed).Equals(tt.wantTestFailed)
+			for _, want := range tt.wantMessage {
+				assert(strings.Contains(strings.Join(dummyT.logs, "\n"), want)).IsTrue()
+			}
+		})
+	}
+}
+
+func TestMatchesRegexpWithGroups(t *testing.T) {
+	type args struct {
+		got        interface{}
+		pattern    interface{}
+		wantGroups map[string]string
+	}
+	tests := []struct {
+		name           string
+		args           args
+		want           bool
+		wantTestFailed bool
+		wantMessage    []string
+	}{
+		{
+			name: "should pass when capture groups have wanted values",
+			args: args{
+				got:        "alice@example.com",
+				pattern:    `^(?P<user>\w+)@(?P<host>[\w.]+)$`,
+				wantGroups: map[string]string{"user": "alice", "host": "example.com"},
+			},
+			want:           true,
+			wantTestFailed: false,
+		},
+		{
+			name: "should fail when capture group has other value",
+			args: args{
+				got:        "alice@example.com",
+				pattern:    `^(?P<user>\w+)@(?P<host>[\w.]+)$`,
+				wantGroups: map[string]string{"user": "bob"},
+			},
+			want:           false,
+			wantTestFailed: true,
+			wantMessage:    []string{`Group user: want "bob", got "alice"`},
+		},
+		{
+			name: "should fail when capture group doesn't exist",
+			args: args{
+				got:        "alice@example.com",
+				pattern:    `^(?P<user>\w+)@`,
+				wantGroups: map[string]string{"host": "example.com"},
+			},
+			want:           false,
+			wantTestFailed: true,
+			wantMessage:    []string{`pattern has no capture group named "host"`},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Given
+			assert := New(t)
+			dummyT := &fakeT{}
+			dummyAssert := New(dummyT)
+
+			// When
+			got := dummyAssert(tt.args.got).MatchesRegexpWithGroups(tt.args.pattern, tt.args.wantGroups)
+
+			// Then
+			assert(got).Equals(tt.want)
+			assert(dummyT.failed).Equals(tt.wantTestFailed)
+			for _, want := range tt.wantMessage {
+				assert(strings.Contains(strings.Join(dummyT.logs, "\n"), want)).IsTrue()
+			}
+		})
+	}
+}
+
+func TestContainsLine(t *testing.T) {
+	type args struct {
+		got  interface{}
+		want string
+	}
+	tests := []struct {
+		name           string
+		args           args
+		want           bool
+		wantTestFailed bool
+	}{
+		{
+			name:           "should pass when text contains line",
+			args:           args{got: "first line\r\nsecond line\n", want: "first line"},
+			want:           true,
+			wantTestFailed: false,
+		},
+		{
+			name:           "should fail when text only contains part of line",
+			args:           args{got: "first line\r\nsecond line\n", want: "second"},
+			want:           false,
+			wantTestFailed: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Given
+			assert := New(t)
+			dummyT := &fakeT{}
+			dummyAssert := New(dummyT)
+
+			// When
+			got := dummyAssert(tt.args.got).ContainsLine(tt.args.want)
+
+			// Then
+			assert(got).Equals(tt.want)
+			assert(dummyT.failed).Equals(tt.wantTestFailed)
+		})
+	}
+}
+
+func TestHasLineCount(t *testing.T) {
+	type args struct {
+		got  interface{}
+		want int
+	}
+	tests := []struct {
+		name           string
+		args           args
+		want           bool
+		wantTestFailed bool
+		wantMessage    []string
+	}{
+		{
+			name:           "should pass when text has line count",
+			args:           args{got: "first line\r\nsecond line\n", want: 2},
+			want:           true,
+			wantTestFailed: false,
+		},
+		{
+			name:           "should fail when text has other line count",
+			args:           args{got: "first line\r\nsecond line\nthird line", want: 2},
+			want:           false,
+			wantTestFailed: true,
+			wantMessage:    []string{"Line count: want 2, got 3"},
+		},
+		{
+			name:           "should pass when empty text has no lines",
+			args:           args{got: "", want: 0},
+			want:           true,
+			wantTestFailed: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Given
+			assert := New(t)
+			dummyT := &fakeT{}
+			dummyAssert := New(dummyT)
+
+			// When
+			got := dummyAssert(tt.args.got).HasLineCount(tt.args.want)
+
+			// Then
+			assert(got).Equals(tt.want)
+			assert(dummyT.failed).Equals(tt.wantTestFailed)
+			for _, want := range tt.wantMessage {
+				assert(strings.Contains(strings.Join(dummyT.logs, "\n"), want)).IsTrue()
+			}
+		})
+	}
+}
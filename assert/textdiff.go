@@ -0,0 +1,285 @@
+package assert
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// diffContextLines is the number of unchanged lines shown around every change in a text diff.
+const diffContextLines = 3
+
+type editOp int
+
+const (
+	editEqual editOp = iota
+	editDelete
+	editInsert
+)
+
+// edit is a single step in an edit script turning one sequence of lines into another.
+type edit struct {
+	op   editOp
+	line string
+	// wantLine and gotLine are the zero-based line numbers in the 'want' and 'got' texts before
+	// the edit is applied
+	wantLine int
+	gotLine  int
+}
+
+// rawText returns the value as a string if it's a string or byte slice, including named types
+// based on them.
+func rawText(v interface{}) (string, bool) {
+	if v == nil {
+		return "", false
+	}
+	value := reflect.ValueOf(v)
+	switch {
+	case value.Kind() == reflect.String:
+		return value.String(), true
+	case value.Kind() == reflect.Slice && value.Type().Elem().Kind() == reflect.Uint8:
+		return string(value.Bytes()), true
+	default:
+		return "", false
+	}
+}
+
+// textSummary describes a string or byte slice by its number of lines. It's shown in place of
+// texts whose line based diff is shown.
+func textSummary(v interface{}) string {
+	text, _ := rawText(v)
+	if n := len(lines(text)); n != 1 {
+		return fmt.Sprintf("text of %d lines, see the diff below", n)
+	}
+	return "text of 1 line, see the diff below"
+}
+
+func isMultiLine(text string) bool {
+	return strings.Contains(strings.TrimSuffix(text, "\n"), "\n")
+}
+
+// maxDiffEditDistance limits the number of inserted and deleted lines of a text diff, since the
+// memory needed to compute it grows with the square of that number. Texts that differ more than
+// that aren't diffed.
+const maxDiffEditDistance = 1000
+
+// myersDiff returns the shortest edit script turning the lines in 'want' into the lines in 'got',
+// using Myers' O(ND) difference algorithm. The second return value is false if the script would
+// have more than maxDiffEditDistance insertions and deletions.
+func myersDiff(want, got []string) ([]edit, bool) {
+	n, m := len(want), len(got)
+	maxD := n + m
+	if maxD > maxDiffEditDistance {
+		maxD = maxDiffEditDistance
+	}
+	offset := maxD + 1
+	v := make([]int, 2*maxD+3)
+	// trace holds the furthest reaching paths of diagonals -d to d before step d
+	var trace [][]int
+
+	for d := 0; d <= maxD; d++ {
+		trace = append(trace, append([]int(nil), v[offset-d:offset+d+1]...))
+		for k := -d; k <= d; k += 2 {
+			var x int
+			if k == -d || (k != d && v[offset+k-1] < v[offset+k+1]) {
+				x = v[offset+k+1]
+			} else {
+				x = v[offset+k-1] + 1
+			}
+			y := x - k
+			for x < n && y < m && want[x] == got[y] {
+				x++
+				y++
+			}
+			v[offset+k] = x
+			if x >= n && y >= m {
+				return backtrackEdits(trace, want, got), true
+			}
+		}
+	}
+	return nil, false
+}
+
+// backtrackEdits walks the trace of furthest reaching paths backwards to recover the edit script.
+func backtrackEdits(trace [][]int, want, got []string) []edit {
+	var edits []edit
+	x, y := len(want), len(got)
+	for d := len(trace) - 1; d >= 0; d-- {
+		// The furthest reaching path of diagonal k before step d is at index k+d
+		v := trace[d]
+		k := x - y
+		// Every path starts at the first line of both texts
+		prevK, prevX, prevY := 0, 0, 0
+		if d > 0 {
+			if k == -d || (k != d && v[k-1+d] < v[k+1+d]) {
+				prevK = k + 1
+			} else {
+				prevK = k - 1
+			}
+			prevX = v[prevK+d]
+			prevY = prevX - prevK
+		}
+		for x > prevX && y > prevY {
+			x--
+			y--
+			edits = append(edits, edit{op: editEqual, line: want[x], wantLine: x, gotLine: y})
+		}
+		if d == 0 {
+			break
+		}
+		if x == prevX {
+			y--
+			edits = append(edits, edit{op: editInsert, line: got[y], wantLine: x, gotLine: y})
+		} else {
+			x--
+			edits = append(edits, edit{op: editDelete, line: want[x], wantLine: x, gotLine: y})
+		}
+	}
+
+	for i, j := 0, len(edits)-1; i < j; i, j = i+1, j-1 {
+		edits[i], edits[j] = edits[j], edits[i]
+	}
+	return edits
+}
+
+// unifiedDiff returns a line based diff turning 'want' into 'got' in the unified format. Changed
+// parts of lines that were replaced are highlighted, with [-removed-] and {+added+} markers. If
+// the texts only differ in line endings or a trailing newline, that's described instead. The
+// second return value is false if the texts differ too much to be diffed.
+func unifiedDiff(want, got string) (string, bool) {
+	edits, ok := myersDiff(lines(want), lines(got))
+	if !ok {
+		return "", false
+	}
+
+	var b strings.Builder
+	b.WriteString("--- want\n+++ got")
+	textHunks := hunks(edits)
+	for _, hunk := range textHunks {
+		writeHunk(&b, edits[hunk[0]:hunk[1]])
+	}
+	if len(textHunks) == 0 && want != got {
+		b.WriteString("\n" + lineEndingDifference(want, got))
+	}
+	return b.String(), true
+}
+
+// lineEndingDifference describes how texts with equal lines differ, which is in their line
+// endings or in a trailing newline.
+func lineEndingDifference(want, got string) string {
+	var differences []string
+	if wantCRLF, gotCRLF := strings.Count(want, "\r\n"), strings.Count(got, "\r\n"); wantCRLF != gotCRLF {
+		differences = append(differences, fmt.Sprintf(
+			"Line endings differ: %d lines of expected text and %d lines of observed text end with \\r\\n",
+			wantCRLF, gotCRLF))
+	}
+	switch wantNewline, gotNewline := strings.HasSuffix(want, "\n"), strings.HasSuffix(got, "\n"); {
+	case wantNewline && !gotNewline:
+		differences = append(differences, "Expected text ends with a newline, observed text doesn't")
+	case !wantNewline && gotNewline:
+		differences = append(differences, "Observed text ends with a newline, expected text doesn't")
+	}
+	if len(differences) == 0 {
+		return "Texts only differ in line endings"
+	}
+	return strings.Join(differences, "\n")
+}
+
+// hunks returns the [start, end) ranges of edits to include in the diff, covering every change and
+// its surrounding context.
+func hunks(edits []edit) [][2]int {
+	var ranges [][2]int
+	for i, e := range edits {
+		if e.op == editEqual {
+			continue
+		}
+		start, end := i-diffContextLines, i+diffContextLines+1
+		if start < 0 {
+			start = 0
+		}
+		if end > len(edits) {
+			end = len(edits)
+		}
+		if len(ranges) > 0 && start <= ranges[len(ranges)-1][1] {
+			ranges[len(ranges)-1][1] = end
+			continue
+		}
+		ranges = append(ranges, [2]int{start, end})
+	}
+	return ranges
+}
+
+func writeHunk(b *strings.Builder, edits []edit) {
+	wantCount, gotCount := 0, 0
+	for _, e := range edits {
+		if e.op != editInsert {
+			wantCount++
+		}
+		if e.op != editDelete {
+			gotCount++
+		}
+	}
+	fmt.Fprintf(b, "\n@@ -%s +%s @@", hunkRange(edits[0].wantLine, wantCount), hunkRange(edits[0].gotLine, gotCount))
+
+	for i := 0; i < len(edits); {
+		if edits[i].op == editEqual {
+			b.WriteString("\n " + edits[i].line)
+			i++
+			continue
+		}
+
+		// A run of deleted lines followed by a run of inserted lines is a replacement, so the
+		// deleted and inserted lines are paired up to highlight their changed parts
+		var deleted, inserted []string
+		for ; i < len(edits) && edits[i].op == editDelete; i++ {
+			deleted = append(deleted, edits[i].line)
+		}
+		for ; i < len(edits) && edits[i].op == editInsert; i++ {
+			inserted = append(inserted, edits[i].line)
+		}
+		for j, line := range deleted {
+			if j < len(inserted) {
+				line, _ = highlightChange(line, inserted[j])
+			}
+			b.WriteString("\n-" + line)
+		}
+		for j, line := range inserted {
+			if j < len(deleted) {
+				_, line = highlightChange(deleted[j], line)
+			}
+			b.WriteString("\n+" + line)
+		}
+	}
+}
+
+// hunkRange formats the zero-based start line and line count of a hunk in the unified format.
+func hunkRange(start, count int) string {
+	if count == 0 {
+		return fmt.Sprintf("%d,0", start)
+	}
+	return fmt.Sprintf("%d,%d", start+1, count)
+}
+
+// highlightChange marks the part of the lines that differs, between their common prefix and
+// common suffix.
+func highlightChange(want, got string) (string, string) {
+	wantRunes, gotRunes := []rune(want), []rune(got)
+	prefix := 0
+	for prefix < len(wantRunes) && prefix < len(gotRunes) && wantRunes[prefix] == gotRunes[prefix] {
+		prefix++
+	}
+	suffix := 0
+	for suffix < len(wantRunes)-prefix && suffix < len(gotRunes)-prefix &&
+		wantRunes[len(wantRunes)-1-suffix] == gotRunes[len(gotRunes)-1-suffix] {
+		suffix++
+	}
+
+	mark := func(runes []rune, open, closing string) string {
+		changed := runes[prefix : len(runes)-suffix]
+		if len(changed) == 0 {
+			return string(runes)
+		}
+		return string(runes[:prefix]) + open + string(changed) + closing + string(runes[len(runes)-suffix:])
+	}
+	return mark(wantRunes, "[-", "-]"), mark(gotRunes, "{+", "+}")
+}
@@ -0,0 +1,226 @@
+package assert
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestUnifiedDiff(t *testing.T) {
+	type args struct {
+		want string
+		got  string
+	}
+	tests := []struct {
+		name string
+		args args
+		want []string
+	}{
+		{
+			name: "should return only header when texts are equal",
+			args: args{want: "a\nb\n", got: "a\nb\n"},
+			want: []string{"--- want", "+++ got"},
+		},
+		{
+			name: "should highlight changed part of replaced line",
+			args: args{want: "a\nprice: 10\nc", got: "a\nprice: 12\nc"},
+			want: []string{
+				"--- want",
+				"+++ got",
+				"@@ -1,3 +1,3 @@",
+				" a",
+				"-price: 1[-0-]",
+				"+price: 1{+2+}",
+				" c",
+			},
+		},
+		{
+			name: "should return inserted lines when want is empty",
+			args: args{want: "", got: "a\nb"},
+			want: []string{"--- want", "+++ got", "@@ -0,0 +1,2 @@", "+a", "+b"},
+		},
+		{
+			name: "should return deleted lines when got is empty",
+			args: args{want: "a\nb", got: ""},
+			want: []string{"--- want", "+++ got", "@@ -1,2 +0,0 @@", "-a", "-b"},
+		},
+		{
+			name: "should split distant changes into separate hunks",
+			args: args{
+				want: "1\n2\n3\n4\n5\n6\n7\n8\n9\n10\n11\n12",
+				got:  "one\n2\n3\n4\n5\n6\n7\n8\n9\n10\n11\ntwelve",
+			},
+			want: []string{
+				"--- want",
+				"+++ got",
+				"@@ -1,4 +1,4 @@",
+				"-[-1-]",
+				"+{+one+}",
+				" 2",
+				" 3",
+				" 4",
+				"@@ -9,4 +9,4 @@",
+				" 9",
+				" 10",
+				" 11",
+				"-[-12-]",
+				"+{+twelve+}",
+			},
+		},
+		{
+			name: "should describe missing trailing newline",
+			args: args{want: "a\nb\n", got: "a\nb"},
+			want: []string{"--- want", "+++ got", "Expected text ends with a newline, observed text doesn't"},
+		},
+		{
+			name: "should describe unexpected trailing newline",
+			args: args{want: "a\nb", got: "a\nb\n"},
+			want: []string{"--- want", "+++ got", "Observed text ends with a newline, expected text doesn't"},
+		},
+		{
+			name: "should describe different line endings",
+			args: args{want: "a\r\nb", got: "a\nb"},
+			want: []string{"--- want", "+++ got", `Line endings differ: 1 lines of expected text and 0 lines of observed text end with \r\n`},
+		},
+		{
+			name: "should not highlight lines without a replacement",
+			args: args{want: "a\nc", got: "a\nb\nc"},
+			want: []string{"--- want", "+++ got", "@@ -1,2 +1,3 @@", " a", "+b", " c"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Given
+			assert := New(t)
+
+			// When
+			got, ok := unifiedDiff(tt.args.want, tt.args.got)
+
+			// Then
+			assert(ok).IsTrue()
+			assert(got).Equals(strings.Join(tt.want, "\n"))
+		})
+	}
+}
+
+func TestUnifiedDiffOfVeryDifferentTexts(t *testing.T) {
+	// Given
+	assert := New(t)
+	var want, got strings.Builder
+	for i := 0; i < 4000; i++ {
+		fmt.Fprintf(&want, "want %d\n", i)
+		fmt.Fprintf(&got, "got %d\n", i)
+	}
+
+	// When
+	_, ok := unifiedDiff(want.String(), got.String())
+
+	// Then
+	assert(ok).IsFalse()
+}
+
+func TestEqualsReportsTextDiff(t *testing.T) {
+	// Given
+	assert := New(t)
+	dummyT := &fakeT{}
+	dummyAssert := New(dummyT)
+
+	// When
+	dummyAssert([]byte("SELECT *\nFROM orders\n")).Equals([]byte("SELECT *\nFROM users\n"))
+
+	// Then
+	assert(len(dummyT.logs)).Equals(1)
+	assert(strings.Contains(dummyT.logs[0], "-FROM [-us-]ers")).IsTrue()
+	assert(strings.Contains(dummyT.logs[0], "+FROM {+ord+}ers")).IsTrue()
+}
+
+func TestEqualsSummarizesDiffedTexts(t *testing.T) {
+	// Given
+	assert := New(t)
+	dummyT := &fakeT{}
+	dummyAssert := New(dummyT)
+
+	// When
+	dummyAssert("a\nb\nc").Equals("a\nx\nc\nd")
+
+	// Then
+	assert(len(dummyT.logs)).Equals(1)
+	assert(strings.Contains(dummyT.logs[0], "Expected: text of 4 lines, see the diff below\n")).IsTrue()
+	assert(strings.Contains(dummyT.logs[0], "Observed: text of 3 lines, see the diff below\n")).IsTrue()
+}
+
+func TestEqualsIndentsMultiLineValues(t *testing.T) {
+	// Given
+	assert := New(t)
+	dummyT := &fakeT{}
+	dummyAssert := New(dummyT)
+	var want, got strings.Builder
+	for i := 0; i < 4000; i++ {
+		fmt.Fprintf(&want, "want %d\n", i)
+		fmt.Fprintf(&got, "got %d\n", i)
+	}
+
+	// When
+	dummyAssert(got.String()).Equals(want.String())
+
+	// Then
+	assert(len(dummyT.logs)).Equals(1)
+	assert(strings.Contains(dummyT.logs[0], "\tExpected: want 0\n\twant 1\n")).IsTrue()
+	assert(strings.Contains(dummyT.logs[0], "\tObserved: got 0\n\tgot 1\n")).IsTrue()
+}
+
+func TestEqualsReportsTypesOfTextsInsteadOfTextDiff(t *testing.T) {
+	type text string
+	type args struct {
+		got  interface{}
+		want interface{}
+	}
+	tests := []struct {
+		name        string
+		args        args
+		wantDetails string
+	}{
+		{
+			name:        "should report types when get byte slice and want string",
+			args:        args{got: []byte("a\nb\n"), want: "a\nb\n"},
+			wantDetails: `(root): want "a\nb\n" (string), got [97 10 98 10] ([]uint8)`,
+		},
+		{
+			name:        "should report types when get named string and want string",
+			args:        args{got: text("a\nb"), want: "a\nb"},
+			wantDetails: `(root): want "a\nb" (string), got "a\nb" (assert.text)`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Given
+			assert := New(t)
+			dummyT := &fakeT{}
+			dummyAssert := New(dummyT)
+
+			// When
+			dummyAssert(tt.args.got).Equals(tt.args.want)
+
+			// Then
+			assert(len(dummyT.logs)).Equals(1)
+			assert(strings.Contains(dummyT.logs[0], tt.wantDetails)).IsTrue()
+			assert(strings.Contains(dummyT.logs[0], "--- want")).IsFalse()
+			assert(strings.Contains(dummyT.logs[0], "see the diff below")).IsFalse()
+		})
+	}
+}
+
+func TestEqualsShowsTextsDifferingInLineEndings(t *testing.T) {
+	// Given
+	assert := New(t)
+	dummyT := &fakeT{}
+	dummyAssert := New(dummyT)
+
+	// When
+	dummyAssert("a\r\nb").Equals("a\nb")
+
+	// Then
+	assert(len(dummyT.logs)).Equals(1)
+	assert(strings.Contains(dummyT.logs[0], "Expected: a\n\tb\n")).IsTrue()
+	assert(strings.Contains(dummyT.logs[0], "see the diff below")).IsFalse()
+}
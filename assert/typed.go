@@ -0,0 +1,143 @@
+package assert
+
+// Ordered is a constraint that permits any type that supports the operators < <= >= >.
+type Ordered interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr |
+		~float32 | ~float64 |
+		~string
+}
+
+// That returns a typed asserter for the observed value. Its methods only accept expected values
+// of the same type as the observed value, so type mismatches are caught at compile time.
+// If any assertion fails, code execution is allowed to continue, but the test is marked as
+// having failed.
+//
+//	Example:
+//		assert.That(t, got).Equals(5)
+func That[T any](t TestingT, got T) typedAsserter[T] {
+	return typedAsserter[T]{a: asserter{got: got, t: t, fatal: false}}
+}
+
+// ThatFatal is like That, but if any assertion fails, code execution is immediately stopped
+// and the test is marked as having failed.
+func ThatFatal[T any](t TestingT, got T) typedAsserter[T] {
+	return typedAsserter[T]{a: asserter{got: got, t: t, fatal: true}}
+}
+
+// ThatOrdered returns a typed asserter for an observed value of an ordered type. In addition to
+// the methods provided by That, it's possible to make ordered comparisons.
+//
+//	Example:
+//		assert.ThatOrdered(t, got).Between(1, 10)
+func ThatOrdered[T Ordered](t TestingT, got T) orderedAsserter[T] {
+	return orderedAsserter[T]{typedAsserter: That(t, got)}
+}
+
+// ThatOrderedFatal is like ThatOrdered, but if any assertion fails, code execution is
+// immediately stopped and the test is marked as having failed.
+func ThatOrderedFatal[T Ordered](t TestingT, got T) orderedAsserter[T] {
+	return orderedAsserter[T]{typedAsserter: ThatFatal(t, got)}
+}
+
+// ThatSlice returns a typed asserter for an observed slice. In addition to the methods provided
+// by That, it's possible to make assertions about the slice's elements.
+//
+//	Example:
+//		assert.ThatSlice(t, got).Contains("b")
+func ThatSlice[E any](t TestingT, got []E) sliceAsserter[E] {
+	return sliceAsserter[E]{typedAsserter: That(t, got)}
+}
+
+// ThatSliceFatal is like ThatSlice, but if any assertion fails, code execution is immediately
+// stopped and the test is marked as having failed.
+func ThatSliceFatal[E any](t TestingT, got []E) sliceAsserter[E] {
+	return sliceAsserter[E]{typedAsserter: ThatFatal(t, got)}
+}
+
+type typedAsserter[T any] struct {
+	a asserter
+}
+
+// Equals asserts the observed value equals the 'want' argument (expected value).
+// See the untyped asserter's Equals method for the definition of equal.
+func (ta typedAsserter[T]) Equals(want T, opts ...EqualOption) bool {
+	return ta.a.Equals(want, opts...)
+}
+
+// NotEquals asserts the observed value is not equal to the 'want' argument.
+// See the untyped asserter's NotEquals method for the definition of equal.
+func (ta typedAsserter[T]) NotEquals(want T, opts ...EqualOption) bool {
+	return ta.a.NotEquals(want, opts...)
+}
+
+// IsEmpty asserts the observed value is empty. See the untyped asserter's IsEmpty method for
+// the definition of empty.
+func (ta typedAsserter[T]) IsEmpty() bool {
+	return ta.a.IsEmpty()
+}
+
+// IsNotEmpty asserts the observed value isn't empty.
+func (ta typedAsserter[T]) IsNotEmpty() bool {
+	return ta.a.IsNotEmpty()
+}
+
+// IsNil asserts the observed value is nil.
+func (ta typedAsserter[T]) IsNil() bool {
+	return ta.a.IsNil()
+}
+
+// IsNotNil asserts the observed value is not nil.
+func (ta typedAsserter[T]) IsNotNil() bool {
+	return ta.a.IsNotNil()
+}
+
+type orderedAsserter[T Ordered] struct {
+	typedAsserter[T]
+}
+
+// GreaterThan asserts the observed value is strictly greater than the 'want' argument.
+// If not, the function under test is marked as having failed.
+func (oa orderedAsserter[T]) GreaterThan(want T) bool {
+	return oa.a.GreaterThan(want)
+}
+
+// LessThan asserts the observed value is strictly less than the 'want' argument.
+// If not, the function under test is marked as having failed.
+func (oa orderedAsserter[T]) LessThan(want T) bool {
+	return oa.a.LessThan(want)
+}
+
+// AtLeast asserts the observed value is greater than or equal to the 'want' argument.
+// If not, the function under test is marked as having failed.
+func (oa orderedAsserter[T]) AtLeast(want T) bool {
+	return oa.a.AtLeast(want)
+}
+
+// AtMost asserts the observed value is less than or equal to the 'want' argument.
+// If not, the function under test is marked as having failed.
+func (oa orderedAsserter[T]) AtMost(want T) bool {
+	return oa.a.AtMost(want)
+}
+
+// Between asserts the observed value is within the closed interval [low, high]. If not, the
+// function under test is marked as having failed.
+func (oa orderedAsserter[T]) Between(low, high T) bool {
+	return oa.a.Between(low, high)
+}
+
+type sliceAsserter[E any] struct {
+	typedAsserter[[]E]
+}
+
+// Contains asserts the observed slice contains an element equal to the 'want' argument. If not,
+// the function under test is marked as having failed.
+func (sa sliceAsserter[E]) Contains(want E) bool {
+	return sa.a.Contains(want)
+}
+
+// IgnoringOrderEqualsElementsIn asserts the observed slice has the same elements as the 'want'
+// argument, ignoring order. See the untyped asserter's IgnoringOrderEqualsElementsIn method.
+func (sa sliceAsserter[E]) IgnoringOrderEqualsElementsIn(want []E, opts ...EqualOption) bool {
+	return sa.a.IgnoringOrderEqualsElementsIn(want, opts...)
+}
@@ -0,0 +1,290 @@
+package assert
+
+import "testing"
+
+func TestThatEquals(t *testing.T) {
+	type args struct {
+		got  int
+		want int
+	}
+	tests := []struct {
+		name           string
+		args           args
+		want           bool
+		wantTestFailed bool
+	}{
+		{
+			name:           "should pass when equal",
+			args:           args{got: 5, want: 5},
+			want:           true,
+			wantTestFailed: false,
+		},
+		{
+			name:           "should fail when unequal",
+			args:           args{got: 5, want: 6},
+			want:           false,
+			wantTestFailed: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Given
+			assert := New(t)
+			dummyT := &fakeT{}
+
+			// When
+			got := That(dummyT, tt.args.got).Equals(tt.args.want)
+
+			// Then
+			assert(got).Equals(tt.want)
+			assert(dummyT.failed).Equals(tt.wantTestFailed)
+		})
+	}
+}
+
+func TestThatOrderedGreaterThan(t *testing.T) {
+	type args struct {
+		got  float64
+		want float64
+	}
+	tests := []struct {
+		name           string
+		args           args
+		want           bool
+		wantTestFailed bool
+	}{
+		{
+			name:           "should pass when greater than",
+			args:           args{got: 2.5, want: 1.5},
+			want:           true,
+			wantTestFailed: false,
+		},
+		{
+			name:           "should fail when not greater than",
+			args:           args{got: 2.5, want: 2.5},
+			want:           false,
+			wantTestFailed: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Given
+			assert := New(t)
+			dummyT := &fakeT{}
+
+			// When
+			got := ThatOrdered(dummyT, tt.args.got).GreaterThan(tt.args.want)
+
+			// Then
+			assert(got).Equals(tt.want)
+			assert(dummyT.failed).Equals(tt.wantTestFailed)
+		})
+	}
+}
+
+func TestThatOrderedLessThan(t *testing.T) {
+	type args struct {
+		got  float64
+		want float64
+	}
+	tests := []struct {
+		name           string
+		args           args
+		want           bool
+		wantTestFailed bool
+	}{
+		{
+			name:           "should pass when less than",
+			args:           args{got: 2.5, want: 3},
+			want:           true,
+			wantTestFailed: false,
+		},
+		{
+			name:           "should fail when not less than",
+			args:           args{got: 2.5, want: 2.5},
+			want:           false,
+			wantTestFailed: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Given
+			assert := New(t)
+			dummyT := &fakeT{}
+
+			// When
+			got := ThatOrdered(dummyT, tt.args.got).LessThan(tt.args.want)
+
+			// Then
+			assert(got).Equals(tt.want)
+			assert(dummyT.failed).Equals(tt.wantTestFailed)
+		})
+	}
+}
+
+func TestThatOrderedAtLeast(t *testing.T) {
+	type args struct {
+		got  float64
+		want float64
+	}
+	tests := []struct {
+		name           string
+		args           args
+		want           bool
+		wantTestFailed bool
+	}{
+		{
+			name:           "should pass when equal",
+			args:           args{got: 2.5, want: 2.5},
+			want:           true,
+			wantTestFailed: false,
+		},
+		{
+			name:           "should fail when less",
+			args:           args{got: 2.5, want: 3},
+			want:           false,
+			wantTestFailed: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Given
+			assert := New(t)
+			dummyT := &fakeT{}
+
+			// When
+			got := ThatOrdered(dummyT, tt.args.got).AtLeast(tt.args.want)
+
+			// Then
+			assert(got).Equals(tt.want)
+			assert(dummyT.failed).Equals(tt.wantTestFailed)
+		})
+	}
+}
+
+func TestThatOrderedAtMost(t *testing.T) {
+	type args struct {
+		got  float64
+		want float64
+	}
+	tests := []struct {
+		name           string
+		args           args
+		want           bool
+		wantTestFailed bool
+	}{
+		{
+			name:           "should pass when equal",
+			args:           args{got: 2.5, want: 2.5},
+			want:           true,
+			wantTestFailed: false,
+		},
+		{
+			name:           "should fail when greater",
+			args:           args{got: 2.5, want: 2},
+			want:           false,
+			wantTestFailed: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Given
+			assert := New(t)
+			dummyT := &fakeT{}
+
+			// When
+			got := ThatOrdered(dummyT, tt.args.got).AtMost(tt.args.want)
+
+			// Then
+			assert(got).Equals(tt.want)
+			assert(dummyT.failed).Equals(tt.wantTestFailed)
+		})
+	}
+}
+
+func TestThatOrderedBetween(t *testing.T) {
+	type args struct {
+		got  float64
+		low  float64
+		high float64
+	}
+	tests := []struct {
+		name           string
+		args           args
+		want           bool
+		wantTestFailed bool
+	}{
+		{
+			name:           "should pass when at lower bound",
+			args:           args{got: 2.5, low: 2.5, high: 3},
+			want:           true,
+			wantTestFailed: false,
+		},
+		{
+			name:           "should fail when below interval",
+			args:           args{got: 2.5, low: 3, high: 4},
+			want:           false,
+			wantTestFailed: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Given
+			assert := New(t)
+			dummyT := &fakeT{}
+
+			// When
+			got := ThatOrdered(dummyT, tt.args.got).Between(tt.args.low, tt.args.high)
+
+			// Then
+			assert(got).Equals(tt.want)
+			assert(dummyT.failed).Equals(tt.wantTestFailed)
+		})
+	}
+}
+
+func TestThatSliceContains(t *testing.T) {
+	type args struct {
+		got  []string
+		want string
+	}
+	tests := []struct {
+		name           string
+		args           args
+		want           bool
+		wantTestFailed bool
+	}{
+		{
+			name:           "should pass when element found",
+			args:           args{got: []string{"a", "b"}, want: "b"},
+			want:           true,
+			wantTestFailed: false,
+		},
+		{
+			name:           "should fail when element not found",
+			args:           args{got: []string{"a", "b"}, want: "c"},
+			want:           false,
+			wantTestFailed: true,
+		},
+		{
+			name:           "should fail when slice is nil",
+			args:           args{got: nil, want: "a"},
+			want:           false,
+			wantTestFailed: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Given
+			assert := New(t)
+			dummyT := &fakeT{}
+
+			// When
+			got := ThatSlice(dummyT, tt.args.got).Contains(tt.args.want)
+
+			// Then
+			assert(got).Equals(tt.want)
+			assert(dummyT.failed).Equals(tt.wantTestFailed)
+		})
+	}
+}